@@ -1,7 +1,21 @@
+# Look up a benefit by ID
 data "polar_benefit" "example" {
   id = "00000000-0000-0000-0000-000000000000"
 }
 
+# Look up a benefit by type and exact description
+data "polar_benefit" "support" {
+  type        = "custom"
+  description = "Priority support"
+}
+
+# Look up a benefit by metadata
+data "polar_benefit" "license" {
+  metadata_filter = {
+    sku = "pro-license"
+  }
+}
+
 output "benefit_type" {
   value = data.polar_benefit.example.type
 }
@@ -9,3 +23,7 @@ output "benefit_type" {
 output "benefit_description" {
   value = data.polar_benefit.example.description
 }
+
+output "license_key_prefix" {
+  value = data.polar_benefit.license.license_keys_properties.prefix
+}
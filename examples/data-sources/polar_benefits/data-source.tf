@@ -0,0 +1,4 @@
+# All license-key benefits
+data "polar_benefits" "license_keys" {
+  type = "license_keys"
+}
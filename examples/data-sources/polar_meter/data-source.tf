@@ -1,3 +1,13 @@
-data "polar_meter" "example" {
+# Look up a meter by ID
+data "polar_meter" "by_id" {
   id = "00000000-0000-0000-0000-000000000000"
 }
+
+# Look up an active meter by its exact name
+data "polar_meter" "api_calls" {
+  name = "API Calls"
+}
+
+output "api_calls_aggregation" {
+  value = data.polar_meter.api_calls.aggregation.func
+}
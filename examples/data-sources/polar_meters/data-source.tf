@@ -0,0 +1,4 @@
+data "polar_meters" "api" {
+  query       = "API"
+  is_archived = false
+}
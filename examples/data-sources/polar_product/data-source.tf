@@ -0,0 +1,13 @@
+# Look up a product by ID
+data "polar_product" "by_id" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
+
+# Look up an active product by its exact name
+data "polar_product" "pro_plan" {
+  name = "Pro Plan"
+}
+
+resource "polar_checkout_link" "pro" {
+  product_ids = [data.polar_product.pro_plan.id]
+}
@@ -0,0 +1,13 @@
+# All active subscription products owned by the growth team
+data "polar_products" "growth" {
+  is_recurring = true
+  is_archived  = false
+
+  metadata = {
+    team = "growth"
+  }
+}
+
+output "growth_product_ids" {
+  value = data.polar_products.growth.products[*].id
+}
@@ -0,0 +1,20 @@
+# Deliveries to an endpoint over the last 24 hours
+data "polar_webhook_deliveries" "orders" {
+  endpoint_id     = polar_webhook_endpoint.orders.id
+  start_timestamp = timeadd(plantimestamp(), "-24h")
+}
+
+output "webhook_failed_deliveries" {
+  value = data.polar_webhook_deliveries.orders.failed_count
+}
+
+# Warn on plan when more than 10% of recent deliveries failed
+check "webhook_health" {
+  assert {
+    condition = (
+      data.polar_webhook_deliveries.orders.failed_count <=
+      0.1 * length(data.polar_webhook_deliveries.orders.deliveries)
+    )
+    error_message = "More than 10% of webhook deliveries to ${polar_webhook_endpoint.orders.url} failed in the last 24 hours."
+  }
+}
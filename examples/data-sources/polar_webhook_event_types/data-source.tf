@@ -0,0 +1,10 @@
+# Every subscription lifecycle event, plus paid orders
+data "polar_webhook_event_types" "billing" {
+  patterns = ["subscription.*", "order.paid"]
+}
+
+resource "polar_webhook_endpoint" "billing" {
+  url    = "https://example.com/webhooks/billing"
+  format = "raw"
+  events = data.polar_webhook_event_types.billing.event_types
+}
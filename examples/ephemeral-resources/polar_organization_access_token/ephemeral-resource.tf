@@ -0,0 +1,14 @@
+# Mint a read-only token for the duration of the run. It is revoked when the
+# run finishes and never written to state.
+ephemeral "polar_organization_access_token" "ci" {
+  comment    = "CI product sync"
+  scopes     = ["products:read", "benefits:read"]
+  expires_in = "30m"
+}
+
+# Pass it to another provider configuration or a write-only argument.
+provider "polar" {
+  alias        = "read_only"
+  access_token = ephemeral.polar_organization_access_token.ci.token
+  server       = "sandbox"
+}
@@ -0,0 +1,6 @@
+# Benefits have no name, so "name:" matches the exact description.
+# "metadata:<key>=<value>" and plain benefit IDs work too.
+import {
+  to = polar_benefit.welcome_email
+  id = "name:Welcome email with onboarding instructions"
+}
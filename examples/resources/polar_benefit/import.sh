@@ -0,0 +1,5 @@
+# Import by exact description (benefits have no name), by a metadata key and
+# value, or by ID.
+terraform import polar_benefit.welcome_email "name:Welcome email with onboarding instructions"
+terraform import polar_benefit.welcome_email "metadata:tf_key=welcome_email"
+terraform import polar_benefit.welcome_email 00000000-0000-0000-0000-000000000000
@@ -0,0 +1,26 @@
+# Hosted checkout page offering the monthly and yearly plans
+resource "polar_checkout_link" "pricing_page" {
+  product_ids = [
+    polar_product.pro_monthly.id,
+    polar_product.pro_yearly.id,
+  ]
+
+  label       = "Pricing page"
+  success_url = "https://example.com/welcome?checkout_id={CHECKOUT_ID}"
+
+  metadata = {
+    source = "marketing-site"
+  }
+}
+
+# Launch link with a discount pre-applied and code entry disabled
+resource "polar_checkout_link" "launch" {
+  product_ids          = [polar_product.pro_monthly.id]
+  label                = "Launch week"
+  discount_id          = polar_discount.launch.id
+  allow_discount_codes = false
+}
+
+output "pricing_page_url" {
+  value = polar_checkout_link.pricing_page.url
+}
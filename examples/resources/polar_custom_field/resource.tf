@@ -0,0 +1,49 @@
+# Free-form text field for business customers
+resource "polar_custom_field" "vat_number" {
+  type = "text"
+  slug = "vat-number"
+  name = "VAT Number"
+
+  text_properties = {
+    form_label       = "VAT number"
+    form_placeholder = "EU123456789"
+    max_length       = 32
+  }
+}
+
+# Dropdown with a fixed set of options
+resource "polar_custom_field" "team_size" {
+  type = "select"
+  slug = "team-size"
+  name = "Team Size"
+
+  select_properties = {
+    form_label = "How large is your team?"
+    options = [
+      { value = "1-10", label = "1–10 people" },
+      { value = "11-50", label = "11–50 people" },
+      { value = "51+", label = "More than 50" },
+    ]
+  }
+}
+
+# Collect both fields when buying the product; only team size is mandatory
+resource "polar_product" "team_plan" {
+  name               = "Team Plan"
+  recurring_interval = "month"
+
+  prices = [{
+    amount_type  = "fixed"
+    price_amount = 4900
+  }]
+
+  attached_custom_fields = [
+    {
+      custom_field_id = polar_custom_field.vat_number.id
+    },
+    {
+      custom_field_id = polar_custom_field.team_size.id
+      required        = true
+    },
+  ]
+}
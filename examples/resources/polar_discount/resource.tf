@@ -0,0 +1,28 @@
+# 25% off the first payment, redeemable with a code
+resource "polar_discount" "launch" {
+  name         = "Launch Week"
+  type         = "percentage"
+  basis_points = 2500
+  duration     = "once"
+  code         = "LAUNCH25"
+
+  starts_at       = "2025-06-01T00:00:00Z"
+  ends_at         = "2025-06-08T00:00:00Z"
+  max_redemptions = 500
+}
+
+# $5 off for three months, restricted to a single product
+resource "polar_discount" "pro_intro" {
+  name               = "Pro Intro Offer"
+  type               = "fixed"
+  amount             = 500
+  currency           = "usd"
+  duration           = "repeating"
+  duration_in_months = 3
+
+  product_ids = [polar_product.pro.id]
+
+  metadata = {
+    campaign = "intro"
+  }
+}
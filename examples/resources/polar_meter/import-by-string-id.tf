@@ -0,0 +1,6 @@
+# "name:" matches the exact meter name.
+# "metadata:<key>=<value>" and plain meter IDs work too.
+import {
+  to = polar_meter.api_calls
+  id = "name:API Calls"
+}
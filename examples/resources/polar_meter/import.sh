@@ -0,0 +1,4 @@
+# Import by exact meter name, by a metadata key and value, or by ID.
+terraform import polar_meter.api_calls "name:API Calls"
+terraform import polar_meter.api_calls "metadata:tf_key=api_calls"
+terraform import polar_meter.api_calls 00000000-0000-0000-0000-000000000000
@@ -38,3 +38,47 @@ resource "polar_meter" "data_transfer" {
     unit = "bytes"
   }
 }
+
+# Count premium API calls: (name eq api_call AND plan eq pro) OR name eq admin_call
+resource "polar_meter" "premium_calls" {
+  name = "Premium API Calls"
+
+  filter = {
+    conjunction = "or"
+    clauses = [{
+      property = "name"
+      operator = "eq"
+      value    = "admin_call"
+    }]
+    groups = [{
+      conjunction = "and"
+      clauses = [
+        { property = "name", operator = "eq", value = "api_call" },
+        { property = "plan", operator = "eq", value = "pro" },
+      ]
+    }]
+  }
+
+  aggregation = {
+    func = "count"
+  }
+}
+
+# Sum LLM tokens for large completions only. value_type = "integer" makes the
+# API compare numerically rather than as strings.
+resource "polar_meter" "large_completion_tokens" {
+  name = "Large Completion Tokens"
+
+  filter = {
+    conjunction = "and"
+    clauses = [
+      { property = "name", operator = "eq", value = "completion" },
+      { property = "tokens", operator = "gt", value = "1000", value_type = "integer" },
+    ]
+  }
+
+  aggregation = {
+    func     = "sum"
+    property = "tokens"
+  }
+}
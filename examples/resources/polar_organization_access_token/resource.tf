@@ -0,0 +1,16 @@
+# A least-privilege token for a service that only reads the catalog.
+resource "polar_organization_access_token" "catalog_sync" {
+  comment    = "catalog-sync service"
+  scopes     = ["products:read", "benefits:read"]
+  expires_in = "2160h" # 90 days
+
+  # Rotation replaces the token; create the new one before revoking the old.
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+output "catalog_sync_token" {
+  value     = polar_organization_access_token.catalog_sync.token
+  sensitive = true
+}
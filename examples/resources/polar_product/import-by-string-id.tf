@@ -0,0 +1,6 @@
+# "name:" matches the exact product name.
+# "metadata:<key>=<value>" and plain product IDs work too.
+import {
+  to = polar_product.pro_plan
+  id = "name:Pro Plan"
+}
@@ -0,0 +1,4 @@
+# Import by exact product name, by a metadata key and value, or by ID.
+terraform import polar_product.pro_plan "name:Pro Plan"
+terraform import polar_product.pro_plan "metadata:tf_key=pro"
+terraform import polar_product.pro_plan 00000000-0000-0000-0000-000000000000
@@ -61,6 +61,21 @@ resource "polar_product" "api_access" {
   }]
 }
 
+# Team plan with tiered per-seat pricing
+resource "polar_product" "team_plan" {
+  name               = "Team Plan"
+  description        = "Per-seat pricing with volume discounts."
+  recurring_interval = "month"
+
+  prices = [{
+    amount_type = "seat_based"
+    seat_tiers = [
+      { min_seats = 1, max_seats = 10, price_per_seat = 1000 },
+      { min_seats = 11, price_per_seat = 800 },
+    ]
+  }]
+}
+
 # Product with attached benefits
 resource "polar_product" "pro_with_benefits" {
   name               = "Pro Plan with Benefits"
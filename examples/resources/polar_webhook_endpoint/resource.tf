@@ -15,3 +15,30 @@ resource "polar_webhook_endpoint" "discord" {
   format = "discord"
   events = ["order.created"]
 }
+
+# Rotate the server-generated secret every 90 days
+resource "time_rotating" "webhook_secret" {
+  rotation_days = 90
+}
+
+resource "polar_webhook_endpoint" "rotated" {
+  url                   = "https://example.com/webhooks/polar-rotated"
+  format                = "raw"
+  events                = ["order.paid"]
+  rotate_secret_trigger = time_rotating.webhook_secret.id
+}
+
+# Keep the secret out of state: generate it ephemerally and feed both Polar
+# and your secret manager through write-only arguments (Terraform 1.11+).
+ephemeral "random_password" "webhook_secret" {
+  length  = 48
+  special = false
+}
+
+resource "polar_webhook_endpoint" "write_only" {
+  url               = "https://example.com/webhooks/polar-write-only"
+  format            = "raw"
+  events            = ["order.paid"]
+  secret_wo         = ephemeral.random_password.webhook_secret.result
+  secret_wo_version = 1 # bump to send a new secret
+}
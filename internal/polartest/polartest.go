@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: MPL-2.0
+
+// Package polartest runs an in-memory fake of the Polar API endpoints the
+// provider uses, so resource lifecycles can be tested with plain go test,
+// without an access token or network access.
+//
+// The fake covers products (including benefit attachment), meters, benefits,
+// webhook endpoints and organizations, including the raw PATCH the provider
+// sends for organization settings the SDK can't express. Objects echo back
+// what was written, plus the fields Polar assigns on the server (IDs,
+// timestamps, price IDs, webhook secrets).
+//
+// Discounts, checkout links, custom fields and organization access tokens are
+// not covered: those endpoints, like any other the fake doesn't know, answer
+// 501, so the resources built on them can only be tested against the real API.
+//
+// Two knobs reproduce the behavior the provider has to cope with against the
+// real API: SetLag makes reads trail writes, as Polar's eventually consistent
+// reads do, and InjectFault answers matching requests with an error status
+// such as 429 or 503 before they reach the fake.
+package polartest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OrganizationID is the ID of the organization every Server starts with. It
+// stands in for the organization an access token belongs to: objects created
+// without an organization_id are assigned to it.
+const OrganizationID = "00000000-0000-4000-8000-000000000001"
+
+// Request is a request received by a Server.
+type Request struct {
+	Method string
+	Path   string
+	// Status is the status code the server answered with.
+	Status int
+}
+
+// Fault makes a Server answer matching requests with an error instead of
+// handling them.
+type Fault struct {
+	// Method matches the request method, or any method when empty.
+	Method string
+	// Path matches requests whose path starts with it, or any path when empty.
+	Path string
+	// Status is the error status to answer with, e.g. 429 or 503.
+	Status int
+	// RetryAfter is sent as the Retry-After header when non-empty.
+	RetryAfter string
+	// Times is how many matching requests fail before the fault clears.
+	// Zero fails one request.
+	Times int
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	return (f.Method == "" || f.Method == r.Method) && strings.HasPrefix(r.URL.Path, f.Path)
+}
+
+// Server is a fake Polar API. The zero value is not usable; call NewServer.
+type Server struct {
+	server *httptest.Server
+	mux    *http.ServeMux
+
+	mu       sync.Mutex
+	clock    time.Time
+	nextID   int
+	lag      int
+	faults   []*Fault
+	requests []Request
+	kinds    map[string]*kind
+	objects  map[string]*object
+	order    []string // object IDs in creation order, for listing
+}
+
+// NewServer starts a Server holding one organization, OrganizationID. Call
+// Close when done.
+func NewServer() *Server {
+	s := &Server{
+		mux:     http.NewServeMux(),
+		objects: map[string]*object{},
+		nextID:  1, // OrganizationID
+	}
+	s.routes()
+	s.seedOrganization()
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// URL returns the server's base URL, for the provider's base_url.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// SetLag makes the next n reads of an object after each write return it as
+// it was before the write, or 404 if the write created it. Lists are never
+// stale. Zero, the default, makes every read current.
+func (s *Server) SetLag(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lag = n
+}
+
+// InjectFault queues a fault. Faults are checked in the order they were
+// injected, and each request consumes at most one.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if f.Times == 0 {
+		f.Times = 1
+	}
+	s.faults = append(s.faults, &f)
+}
+
+// Requests returns every request received so far, including failed ones.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+// Object returns the current JSON of the object with the given ID, ignoring
+// any lag, or nil if it doesn't exist or was deleted.
+func (s *Server) Object(id string) map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.objects[id]
+	if !ok || o.current == nil {
+		return nil
+	}
+	return clone(s.render(o.kind, o.current))
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	defer func() {
+		s.mu.Lock()
+		s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Status: rec.status})
+		s.mu.Unlock()
+	}()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(rec, http.StatusUnauthorized, "Unauthorized", "missing bearer token")
+		return
+	}
+	if f := s.takeFault(r); f != nil {
+		if f.RetryAfter != "" {
+			rec.Header().Set("Retry-After", f.RetryAfter)
+		}
+		writeError(rec, f.Status, "InjectedFault", "polartest: injected "+strconv.Itoa(f.Status))
+		return
+	}
+	s.mux.ServeHTTP(rec, r)
+}
+
+// takeFault consumes and returns the first fault matching r, if any.
+func (s *Server) takeFault(r *http.Request) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, f := range s.faults {
+		if !f.matches(r) {
+			continue
+		}
+		f.Times--
+		if f.Times == 0 {
+			s.faults = slices.Delete(s.faults, i, i+1)
+		}
+		return f
+	}
+	return nil
+}
+
+// now returns a strictly increasing timestamp, so every write sorts after
+// the one before it even when both land in the same clock tick.
+func (s *Server) now() string {
+	t := time.Now().UTC()
+	if !t.After(s.clock) {
+		t = s.clock.Add(time.Microsecond)
+	}
+	s.clock = t
+	return t.Format(time.RFC3339Nano)
+}
+
+// newID returns a UUID-shaped ID that is unique within the server.
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.nextID)
+}
+
+// statusRecorder remembers the status code written, for the request log.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError answers with Polar's error body, {"error": ..., "detail": ...}.
+func writeError(w http.ResponseWriter, status int, name, detail string) {
+	writeJSON(w, status, map[string]any{"error": name, "detail": detail})
+}
+
+// writeValidationError answers 422 with FastAPI's validation error body.
+func writeValidationError(w http.ResponseWriter, field, msg string) {
+	writeJSON(w, http.StatusUnprocessableEntity, map[string]any{
+		"detail": []map[string]any{{
+			"type": "value_error",
+			"loc":  []string{"body", field},
+			"msg":  msg,
+		}},
+	})
+}
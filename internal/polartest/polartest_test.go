@@ -0,0 +1,358 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package polartest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/polarsource/polar-go/models/apierrors"
+	"github.com/polarsource/polar-go/models/components"
+)
+
+// Responses are decoded with polar-go's models, which reject objects missing
+// required fields, so these tests also check the fake's JSON matches what
+// the SDK expects from the real API.
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	s := NewServer()
+	t.Cleanup(s.Close)
+	return s
+}
+
+// call sends a JSON request to the server and returns the status and body.
+func call(t *testing.T, s *Server, method, path string, body any) (int, []byte) {
+	t.Helper()
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, s.URL()+path, reqBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer polar_oat_test")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, raw
+}
+
+// mustCall is call that fails the test unless the status is want, decoding
+// a non-empty body into T.
+func mustCall[T any](t *testing.T, s *Server, want int, method, path string, body any) T {
+	t.Helper()
+	status, raw := call(t, s, method, path, body)
+	if status != want {
+		t.Fatalf("%s %s = %d, want %d: %s", method, path, status, want, raw)
+	}
+	var out T
+	if len(raw) == 0 {
+		return out
+	}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		t.Fatalf("decoding %s %s: %v\n%s", method, path, err, raw)
+	}
+	return out
+}
+
+func TestServer_products(t *testing.T) {
+	s := newTestServer(t)
+
+	meter := mustCall[components.Meter](t, s, http.StatusCreated, http.MethodPost, "/v1/meters/", map[string]any{
+		"name":        "API Calls",
+		"filter":      map[string]any{"conjunction": "and", "clauses": []any{}},
+		"aggregation": map[string]any{"func": "count"},
+	})
+	product := mustCall[components.Product](t, s, http.StatusCreated, http.MethodPost, "/v1/products/", map[string]any{
+		"name":               "Pro",
+		"recurring_interval": "month",
+		"metadata":           map[string]any{"tier": "pro"},
+		"prices": []any{
+			map[string]any{"amount_type": "fixed", "price_amount": 1999},
+			map[string]any{"amount_type": "metered_unit", "meter_id": meter.ID, "unit_amount": 0.5},
+		},
+	})
+	if product.OrganizationID != OrganizationID || !product.IsRecurring || len(product.Prices) != 2 {
+		t.Fatalf("created product = %+v", product)
+	}
+	fixed := product.Prices[0].ProductPrice.ProductPriceFixed
+	metered := product.Prices[1].ProductPrice.ProductPriceMeteredUnit
+	if fixed == nil || fixed.PriceCurrency != "usd" || fixed.Type != components.ProductPriceTypeRecurring {
+		t.Errorf("fixed price = %+v", fixed)
+	}
+	if metered == nil || metered.UnitAmount != "0.5" || metered.Meter.Name != "API Calls" {
+		t.Errorf("metered price = %+v", metered)
+	}
+
+	// Keep the fixed price by ID, replace the metered one with a custom price.
+	product = mustCall[components.Product](t, s, http.StatusOK, http.MethodPatch, "/v1/products/"+product.ID, map[string]any{
+		"name": "Pro Plus",
+		"prices": []any{
+			map[string]any{"id": fixed.ID},
+			map[string]any{"amount_type": "custom", "minimum_amount": 500},
+		},
+	})
+	if product.Name != "Pro Plus" || len(product.Prices) != 2 || product.Prices[0].ProductPrice.ProductPriceFixed.ID != fixed.ID ||
+		product.Prices[1].ProductPrice.ProductPriceCustom == nil {
+		t.Errorf("updated product = %+v", product)
+	}
+	if product.ModifiedAt == nil || !product.ModifiedAt.After(product.CreatedAt) {
+		t.Errorf("modified_at = %v, want after created_at %v", product.ModifiedAt, product.CreatedAt)
+	}
+
+	benefit := mustCall[components.Benefit](t, s, http.StatusCreated, http.MethodPost, "/v1/benefits/", map[string]any{
+		"type": "custom", "description": "Priority support", "properties": map[string]any{"note": "Email us"},
+	})
+	product = mustCall[components.Product](t, s, http.StatusOK, http.MethodPost, "/v1/products/"+product.ID+"/benefits", map[string]any{
+		"benefits": []string{benefit.BenefitCustom.ID},
+	})
+	if len(product.Benefits) != 1 || product.Benefits[0].BenefitCustom == nil {
+		t.Errorf("benefits = %+v, want the custom benefit", product.Benefits)
+	}
+	if status, _ := call(t, s, http.MethodPost, "/v1/products/"+product.ID+"/benefits", map[string]any{"benefits": []string{"missing"}}); status != http.StatusUnprocessableEntity {
+		t.Errorf("attaching a missing benefit = %d, want 422", status)
+	}
+
+	// Deleted benefits drop out of the product.
+	mustCall[any](t, s, http.StatusNoContent, http.MethodDelete, "/v1/benefits/"+benefit.BenefitCustom.ID, nil)
+	product = mustCall[components.Product](t, s, http.StatusOK, http.MethodGet, "/v1/products/"+product.ID, nil)
+	if len(product.Benefits) != 0 {
+		t.Errorf("benefits after delete = %+v, want none", product.Benefits)
+	}
+
+	mustCall[components.Product](t, s, http.StatusOK, http.MethodPatch, "/v1/products/"+product.ID, map[string]any{"is_archived": true})
+	list := mustCall[components.ListResourceProduct](t, s, http.StatusOK, http.MethodGet, "/v1/products/?is_archived=false", nil)
+	if len(list.Items) != 0 {
+		t.Errorf("unarchived products = %d, want 0", len(list.Items))
+	}
+}
+
+func TestServer_benefitTypes(t *testing.T) {
+	tests := map[string]struct {
+		properties map[string]any
+		check      func(components.Benefit) bool
+	}{
+		"custom": {
+			properties: map[string]any{},
+			check:      func(b components.Benefit) bool { return b.BenefitCustom != nil },
+		},
+		"discord": {
+			properties: map[string]any{"guild_token": "token", "role_id": "role", "kick_member": true},
+			check:      func(b components.Benefit) bool { return b.BenefitDiscord != nil },
+		},
+		"github_repository": {
+			properties: map[string]any{"repository_owner": "acme", "repository_name": "app", "permission": "pull"},
+			check:      func(b components.Benefit) bool { return b.BenefitGitHubRepository != nil },
+		},
+		"downloadables": {
+			properties: map[string]any{"files": []string{"file_1"}},
+			check:      func(b components.Benefit) bool { return b.BenefitDownloadables != nil },
+		},
+		"license_keys": {
+			properties: map[string]any{"prefix": "KEY"},
+			check:      func(b components.Benefit) bool { return b.BenefitLicenseKeys != nil },
+		},
+		"meter_credit": {
+			properties: map[string]any{"units": 100, "rollover": false, "meter_id": "meter_1"},
+			check:      func(b components.Benefit) bool { return b.BenefitMeterCredit != nil },
+		},
+	}
+	s := newTestServer(t)
+	for benefitType, tt := range tests {
+		t.Run(benefitType, func(t *testing.T) {
+			benefit := mustCall[components.Benefit](t, s, http.StatusCreated, http.MethodPost, "/v1/benefits/", map[string]any{
+				"type": benefitType, "description": "A " + benefitType + " benefit", "properties": tt.properties,
+			})
+			if !tt.check(benefit) {
+				t.Errorf("decoded as %s", benefit.Type)
+			}
+		})
+	}
+}
+
+func TestServer_listFilters(t *testing.T) {
+	s := newTestServer(t)
+	for _, m := range []struct{ name, env string }{{"API Calls", "prod"}, {"API Errors", "prod"}, {"Storage", "dev"}} {
+		mustCall[components.Meter](t, s, http.StatusCreated, http.MethodPost, "/v1/meters/", map[string]any{
+			"name":        m.name,
+			"filter":      map[string]any{"conjunction": "and", "clauses": []any{}},
+			"aggregation": map[string]any{"func": "count"},
+			"metadata":    map[string]any{"env": m.env},
+		})
+	}
+
+	tests := map[string]struct {
+		query     string
+		wantNames []string
+		wantPages int64
+	}{
+		"all":            {query: "", wantNames: []string{"API Calls", "API Errors", "Storage"}, wantPages: 1},
+		"query":          {query: "query=api", wantNames: []string{"API Calls", "API Errors"}, wantPages: 1},
+		"metadata":       {query: "metadata[env]=dev", wantNames: []string{"Storage"}, wantPages: 1},
+		"metadata any":   {query: "metadata[env]=dev&metadata[env]=prod", wantNames: []string{"API Calls", "API Errors", "Storage"}, wantPages: 1},
+		"second page":    {query: "limit=2&page=2", wantNames: []string{"Storage"}, wantPages: 2},
+		"other org":      {query: "organization_id=org_other", wantNames: nil, wantPages: 1},
+		"metadata match": {query: "query=storage&metadata[env]=prod", wantNames: nil, wantPages: 1},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			list := mustCall[components.ListResourceMeter](t, s, http.StatusOK, http.MethodGet, "/v1/meters/?"+tt.query, nil)
+			var names []string
+			for _, m := range list.Items {
+				names = append(names, m.Name)
+			}
+			if !slices.Equal(names, tt.wantNames) {
+				t.Errorf("names = %v, want %v", names, tt.wantNames)
+			}
+			if list.Pagination.MaxPage != tt.wantPages {
+				t.Errorf("max_page = %d, want %d", list.Pagination.MaxPage, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestServer_webhookEndpoints(t *testing.T) {
+	s := newTestServer(t)
+
+	endpoint := mustCall[components.WebhookEndpoint](t, s, http.StatusCreated, http.MethodPost, "/v1/webhooks/endpoints", map[string]any{
+		"url": "https://example.com/hook", "format": "raw", "events": []string{"order.created"},
+	})
+	if endpoint.Secret == "" || !endpoint.Enabled {
+		t.Errorf("created endpoint = %+v", endpoint)
+	}
+	reset := mustCall[components.WebhookEndpoint](t, s, http.StatusOK, http.MethodPatch, "/v1/webhooks/endpoints/"+endpoint.ID+"/secret", nil)
+	if reset.Secret == endpoint.Secret {
+		t.Error("secret unchanged after reset")
+	}
+
+	mustCall[any](t, s, http.StatusNoContent, http.MethodDelete, "/v1/webhooks/endpoints/"+endpoint.ID, nil)
+	notFound := mustCall[apierrors.ResourceNotFound](t, s, http.StatusNotFound, http.MethodGet, "/v1/webhooks/endpoints/"+endpoint.ID, nil)
+	if notFound.Detail == "" {
+		t.Error("404 has no detail")
+	}
+}
+
+func TestServer_organizationSettings(t *testing.T) {
+	s := newTestServer(t)
+	path := "/v1/organizations/" + OrganizationID
+
+	// The SDK's PATCH doesn't know prevent_trial_abuse; the provider's raw
+	// PATCH sends it separately. Neither may clobber the other.
+	mustCall[components.Organization](t, s, http.StatusOK, http.MethodPatch, path, map[string]any{
+		"subscription_settings": map[string]any{"prevent_trial_abuse": true},
+	})
+	org := mustCall[components.Organization](t, s, http.StatusOK, http.MethodPatch, path, map[string]any{
+		"name": "Acme",
+		"subscription_settings": map[string]any{
+			"allow_multiple_subscriptions":    true,
+			"allow_customer_updates":          true,
+			"proration_behavior":              "invoice",
+			"benefit_revocation_grace_period": 7,
+		},
+	})
+	if org.Name != "Acme" || org.SubscriptionSettings.ProrationBehavior != "invoice" {
+		t.Errorf("organization = %+v", org)
+	}
+
+	raw := mustCall[struct {
+		SubscriptionSettings map[string]any `json:"subscription_settings"`
+	}](t, s, http.StatusOK, http.MethodGet, path, nil)
+	if raw.SubscriptionSettings["prevent_trial_abuse"] != true || raw.SubscriptionSettings["allow_multiple_subscriptions"] != true {
+		t.Errorf("subscription_settings = %v, want both PATCHes applied", raw.SubscriptionSettings)
+	}
+}
+
+func TestServer_lag(t *testing.T) {
+	s := newTestServer(t)
+	s.SetLag(2)
+
+	meter := mustCall[components.Meter](t, s, http.StatusCreated, http.MethodPost, "/v1/meters/", map[string]any{
+		"name":        "API Calls",
+		"filter":      map[string]any{"conjunction": "and", "clauses": []any{}},
+		"aggregation": map[string]any{"func": "count"},
+	})
+	path := "/v1/meters/" + meter.ID
+
+	// A new object is missing for the lagging reads.
+	for i := range 2 {
+		if status, _ := call(t, s, http.MethodGet, path, nil); status != http.StatusNotFound {
+			t.Fatalf("read %d after create = %d, want 404", i+1, status)
+		}
+	}
+	mustCall[components.Meter](t, s, http.StatusOK, http.MethodGet, path, nil)
+
+	// After an update, lagging reads see the previous version, even across
+	// a second write.
+	mustCall[components.Meter](t, s, http.StatusOK, http.MethodPatch, path, map[string]any{"name": "Renamed"})
+	mustCall[components.Meter](t, s, http.StatusOK, http.MethodPatch, path, map[string]any{"name": "Renamed again"})
+	for i := range 2 {
+		if got := mustCall[components.Meter](t, s, http.StatusOK, http.MethodGet, path, nil); got.Name != "API Calls" {
+			t.Fatalf("read %d after update = %q, want the stale name", i+1, got.Name)
+		}
+	}
+	if got := mustCall[components.Meter](t, s, http.StatusOK, http.MethodGet, path, nil); got.Name != "Renamed again" {
+		t.Errorf("caught-up read = %q, want the latest name", got.Name)
+	}
+
+	// Lists and Object are never stale.
+	if got := s.Object(meter.ID)["name"]; got != "Renamed again" {
+		t.Errorf("Object name = %v", got)
+	}
+}
+
+func TestServer_faults(t *testing.T) {
+	s := newTestServer(t)
+	s.InjectFault(Fault{Method: http.MethodPost, Path: "/v1/meters/", Status: http.StatusTooManyRequests, RetryAfter: "1", Times: 2})
+	s.InjectFault(Fault{Path: "/v1/organizations/", Status: http.StatusServiceUnavailable})
+
+	body := map[string]any{
+		"name":        "API Calls",
+		"filter":      map[string]any{"conjunction": "and", "clauses": []any{}},
+		"aggregation": map[string]any{"func": "count"},
+	}
+	for range 2 {
+		if status, _ := call(t, s, http.MethodPost, "/v1/meters/", body); status != http.StatusTooManyRequests {
+			t.Fatalf("faulted request = %d, want 429", status)
+		}
+	}
+	mustCall[components.Meter](t, s, http.StatusCreated, http.MethodPost, "/v1/meters/", body)
+	mustCall[any](t, s, http.StatusServiceUnavailable, http.MethodGet, "/v1/organizations/"+OrganizationID, nil)
+	mustCall[components.Organization](t, s, http.StatusOK, http.MethodGet, "/v1/organizations/"+OrganizationID, nil)
+	mustCall[any](t, s, http.StatusNotImplemented, http.MethodGet, "/v1/discounts/", nil)
+
+	var statuses []int
+	for _, r := range s.Requests() {
+		statuses = append(statuses, r.Status)
+	}
+	want := []int{429, 429, 201, 503, 200, 501}
+	if !slices.Equal(statuses, want) {
+		t.Errorf("statuses = %v, want %v", statuses, want)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, s.URL()+"/v1/meters/", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("unauthenticated request = %d, want 401", resp.StatusCode)
+	}
+}
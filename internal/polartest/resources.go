@@ -0,0 +1,513 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package polartest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+)
+
+// routes registers the endpoints the fake implements. Paths mirror the ones
+// polar-go and the provider's raw HTTP calls use, trailing slashes included.
+func (s *Server) routes() {
+	products := &kind{name: "product", create: createProduct, update: updateProduct, render: renderProduct,
+		queryField: "name", archived: func(obj map[string]any) bool { return obj["is_archived"] == true }}
+	meters := &kind{name: "meter", create: createMeter, update: updateMeter,
+		queryField: "name", archived: func(obj map[string]any) bool { return obj["archived_at"] != nil }}
+	benefits := &kind{name: "benefit", create: createBenefit, update: updateBenefit, queryField: "description"}
+	webhooks := &kind{name: "webhook endpoint", create: createWebhookEndpoint, update: updateWebhookEndpoint}
+	organizations := &kind{name: "organization", update: updateOrganization, queryField: "name"}
+
+	s.kinds = map[string]*kind{}
+	for _, k := range []*kind{products, meters, benefits, webhooks, organizations} {
+		s.kinds[k.name] = k
+	}
+
+	s.mux.HandleFunc("POST /v1/products/{$}", s.handleCreate(products))
+	s.mux.HandleFunc("GET /v1/products/{$}", s.handleList(products))
+	s.mux.HandleFunc("GET /v1/products/{id}", s.handleGet(products))
+	s.mux.HandleFunc("PATCH /v1/products/{id}", s.handleUpdate(products))
+	s.mux.HandleFunc("POST /v1/products/{id}/benefits", s.handleUpdateProductBenefits(products, benefits))
+
+	s.mux.HandleFunc("POST /v1/meters/{$}", s.handleCreate(meters))
+	s.mux.HandleFunc("GET /v1/meters/{$}", s.handleList(meters))
+	s.mux.HandleFunc("GET /v1/meters/{id}", s.handleGet(meters))
+	s.mux.HandleFunc("PATCH /v1/meters/{id}", s.handleUpdate(meters))
+
+	s.mux.HandleFunc("POST /v1/benefits/{$}", s.handleCreate(benefits))
+	s.mux.HandleFunc("GET /v1/benefits/{$}", s.handleList(benefits))
+	s.mux.HandleFunc("GET /v1/benefits/{id}", s.handleGet(benefits))
+	s.mux.HandleFunc("PATCH /v1/benefits/{id}", s.handleUpdate(benefits))
+	s.mux.HandleFunc("DELETE /v1/benefits/{id}", s.handleDelete(benefits))
+
+	s.mux.HandleFunc("POST /v1/webhooks/endpoints", s.handleCreate(webhooks))
+	s.mux.HandleFunc("GET /v1/webhooks/endpoints", s.handleList(webhooks))
+	s.mux.HandleFunc("GET /v1/webhooks/endpoints/{id}", s.handleGet(webhooks))
+	s.mux.HandleFunc("PATCH /v1/webhooks/endpoints/{id}", s.handleUpdate(webhooks))
+	s.mux.HandleFunc("DELETE /v1/webhooks/endpoints/{id}", s.handleDelete(webhooks))
+	s.mux.HandleFunc("PATCH /v1/webhooks/endpoints/{id}/secret", s.handleResetWebhookSecret(webhooks))
+	s.mux.HandleFunc("GET /v1/webhooks/deliveries", s.handleListWebhookDeliveries)
+
+	s.mux.HandleFunc("GET /v1/organizations/{$}", s.handleList(organizations))
+	s.mux.HandleFunc("GET /v1/organizations/{id}", s.handleGet(organizations))
+	s.mux.HandleFunc("PATCH /v1/organizations/{id}", s.handleUpdate(organizations))
+
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotImplemented, "NotImplemented",
+			fmt.Sprintf("polartest does not implement %s %s", r.Method, r.URL.Path))
+	})
+}
+
+// organizationFor returns the organization a new object belongs to: the
+// body's organization_id, else the one the access token stands for.
+func (s *Server) organizationFor(body map[string]any) string {
+	if id, ok := body["organization_id"].(string); ok && id != "" {
+		return id
+	}
+	return OrganizationID
+}
+
+// --- Products ---
+
+func createProduct(s *Server, id string, body map[string]any) (map[string]any, error) {
+	if name, _ := body["name"].(string); name == "" {
+		return nil, invalid("name", "field required")
+	}
+	product := map[string]any{
+		"name":                     body["name"],
+		"description":              body["description"],
+		"recurring_interval":       body["recurring_interval"],
+		"recurring_interval_count": nil,
+		"trial_interval":           nil,
+		"trial_interval_count":     nil,
+		"is_archived":              false,
+		"metadata":                 map[string]any{},
+		"benefits":                 []any{}, // benefit IDs, expanded by renderProduct
+	}
+	set(product, body, "recurring_interval_count", "metadata")
+	if product["recurring_interval"] != nil && product["recurring_interval_count"] == nil {
+		product["recurring_interval_count"] = json.Number("1")
+	}
+	product["is_recurring"] = product["recurring_interval"] != nil
+
+	prices, _ := body["prices"].([]any)
+	if len(prices) == 0 {
+		return nil, invalid("prices", "at least one price is required")
+	}
+	if err := setProductPrices(s, id, product, prices, nil); err != nil {
+		return nil, err
+	}
+	if err := setProductMedias(s, product, body); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+func updateProduct(s *Server, product, body map[string]any) error {
+	set(product, body, "name", "description", "recurring_interval", "recurring_interval_count", "metadata", "is_archived")
+	product["is_recurring"] = product["recurring_interval"] != nil
+	if prices, ok := body["prices"].([]any); ok {
+		current, _ := product["prices"].([]any)
+		if err := setProductPrices(s, product["id"].(string), product, prices, current); err != nil {
+			return err
+		}
+	}
+	return setProductMedias(s, product, body)
+}
+
+// setProductPrices replaces a product's prices. Entries that only carry an
+// id keep that existing price; the rest create new ones. Prices left out
+// are archived, so they drop out of the product's prices as in Polar.
+func setProductPrices(s *Server, productID string, product map[string]any, prices, current []any) error {
+	result := make([]any, 0, len(prices))
+	for i, p := range prices {
+		price, ok := p.(map[string]any)
+		if !ok {
+			return invalid("prices", "price %d is not an object", i)
+		}
+		if id, ok := price["id"].(string); ok && len(price) == 1 {
+			idx := slices.IndexFunc(current, func(c any) bool { return c.(map[string]any)["id"] == id })
+			if idx < 0 {
+				return invalid("prices", "price %s does not belong to this product", id)
+			}
+			result = append(result, current[idx])
+			continue
+		}
+		created, err := createPrice(s, productID, product["recurring_interval"], price)
+		if err != nil {
+			return err
+		}
+		result = append(result, created)
+	}
+	product["prices"] = result
+	return nil
+}
+
+// createPrice builds a price from a ProductPrice*Create body.
+func createPrice(s *Server, productID string, recurringInterval any, body map[string]any) (map[string]any, error) {
+	priceType := "one_time"
+	if recurringInterval != nil {
+		priceType = "recurring"
+	}
+	amountType, _ := body["amount_type"].(string)
+	price := map[string]any{
+		"id":                 s.newID(),
+		"created_at":         s.now(),
+		"modified_at":        nil,
+		"amount_type":        amountType,
+		"is_archived":        false,
+		"product_id":         productID,
+		"type":               priceType,
+		"recurring_interval": recurringInterval,
+	}
+	if amountType != "free" {
+		price["price_currency"] = "usd"
+		set(price, body, "price_currency")
+	}
+
+	switch amountType {
+	case "fixed":
+		if body["price_amount"] == nil {
+			return nil, invalid("prices", "price_amount is required for fixed prices")
+		}
+		set(price, body, "price_amount")
+	case "custom":
+		price["minimum_amount"], price["maximum_amount"], price["preset_amount"] = nil, nil, nil
+		set(price, body, "minimum_amount", "maximum_amount", "preset_amount")
+	case "free":
+	case "metered_unit":
+		meterID, _ := body["meter_id"].(string)
+		meter := s.current(s.kinds["meter"], meterID)
+		if meter == nil {
+			return nil, invalid("prices", "meter %q does not exist", meterID)
+		}
+		unitAmount, ok := body["unit_amount"].(json.Number)
+		if !ok {
+			if str, isString := body["unit_amount"].(string); isString {
+				unitAmount, ok = json.Number(str), true
+			}
+		}
+		if !ok {
+			return nil, invalid("prices", "unit_amount is required for metered prices")
+		}
+		price["meter_id"] = meterID
+		price["meter"] = map[string]any{"id": meterID, "name": meter["name"]}
+		price["unit_amount"] = unitAmount.String() // the API answers with a decimal string
+		price["cap_amount"] = nil
+		set(price, body, "cap_amount")
+	case "seat_based":
+		if body["seat_tiers"] == nil {
+			return nil, invalid("prices", "seat_tiers is required for seat-based prices")
+		}
+		set(price, body, "seat_tiers")
+	default:
+		return nil, invalid("prices", "unsupported amount_type %q", amountType)
+	}
+	return price, nil
+}
+
+// setProductMedias resolves the media file IDs in body into file objects.
+// Custom field attachments aren't modeled; the fake rejects them rather
+// than silently dropping them.
+func setProductMedias(s *Server, product, body map[string]any) error {
+	if fields, _ := body["attached_custom_fields"].([]any); len(fields) > 0 {
+		return invalid("attached_custom_fields", "polartest does not support custom fields")
+	}
+	if _, ok := product["attached_custom_fields"]; !ok {
+		product["attached_custom_fields"] = []any{}
+	}
+	if _, ok := product["medias"]; !ok {
+		product["medias"] = []any{}
+	}
+	ids, ok := body["medias"].([]any)
+	if !ok {
+		return nil
+	}
+	medias := make([]any, 0, len(ids))
+	for _, id := range ids {
+		medias = append(medias, map[string]any{
+			"id":                     id,
+			"organization_id":        product["organization_id"],
+			"name":                   fmt.Sprintf("%v.png", id),
+			"path":                   fmt.Sprintf("product_media/%v.png", id),
+			"mime_type":              "image/png",
+			"size":                   json.Number("1024"),
+			"storage_version":        nil,
+			"checksum_etag":          nil,
+			"checksum_sha256_base64": nil,
+			"checksum_sha256_hex":    nil,
+			"last_modified_at":       nil,
+			"version":                nil,
+			"service":                "product_media",
+			"is_uploaded":            true,
+			"created_at":             s.now(),
+			"size_readable":          "1 KB",
+			"public_url":             fmt.Sprintf("https://example.com/%v.png", id),
+		})
+	}
+	product["medias"] = medias
+	return nil
+}
+
+// renderProduct expands the product's benefit IDs into the benefits, as
+// they are now. Deleted benefits drop out.
+func renderProduct(s *Server, product map[string]any) map[string]any {
+	out := clone(product)
+	ids, _ := product["benefits"].([]any)
+	benefits := make([]any, 0, len(ids))
+	for _, id := range ids {
+		if benefit := s.current(s.kinds["benefit"], id.(string)); benefit != nil {
+			benefits = append(benefits, benefit)
+		}
+	}
+	out["benefits"] = benefits
+	return out
+}
+
+// handleUpdateProductBenefits replaces a product's benefits with the ones
+// listed in the body, {"benefits": [ids]}.
+func (s *Server) handleUpdateProductBenefits(products, benefits *kind) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, ok := decodeBody(w, r)
+		if !ok {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		o := s.lookup(products, r.PathValue("id"))
+		if o == nil || o.current == nil {
+			writeNotFound(w, products)
+			return
+		}
+		ids, _ := body["benefits"].([]any)
+		for _, id := range ids {
+			if str, _ := id.(string); s.current(benefits, str) == nil {
+				writeValidationError(w, "benefits", fmt.Sprintf("benefit %v does not exist", id))
+				return
+			}
+		}
+		product := clone(o.current)
+		product["benefits"] = cloneValue(ids)
+		product["modified_at"] = s.now()
+		s.write(o, product)
+		writeJSON(w, http.StatusOK, s.render(products.name, product))
+	}
+}
+
+// --- Meters ---
+
+func createMeter(s *Server, id string, body map[string]any) (map[string]any, error) {
+	for _, field := range []string{"name", "filter", "aggregation"} {
+		if body[field] == nil {
+			return nil, invalid(field, "field required")
+		}
+	}
+	meter := map[string]any{"metadata": map[string]any{}, "archived_at": nil}
+	set(meter, body, "name", "filter", "aggregation", "metadata")
+	return meter, nil
+}
+
+func updateMeter(s *Server, meter, body map[string]any) error {
+	set(meter, body, "name", "filter", "aggregation", "metadata")
+	switch body["is_archived"] {
+	case true:
+		if meter["archived_at"] == nil {
+			meter["archived_at"] = s.now()
+		}
+	case false:
+		meter["archived_at"] = nil
+	}
+	return nil
+}
+
+// --- Benefits ---
+
+func createBenefit(s *Server, id string, body map[string]any) (map[string]any, error) {
+	if description, _ := body["description"].(string); description == "" {
+		return nil, invalid("description", "field required")
+	}
+	benefit := map[string]any{
+		"type":        body["type"],
+		"description": body["description"],
+		"selectable":  true,
+		"deletable":   true,
+		"metadata":    map[string]any{},
+	}
+	set(benefit, body, "metadata")
+	if err := setBenefitProperties(benefit, body); err != nil {
+		return nil, err
+	}
+	return benefit, nil
+}
+
+func updateBenefit(s *Server, benefit, body map[string]any) error {
+	if t, ok := body["type"]; ok && t != benefit["type"] {
+		return invalid("type", "the type of a benefit can't be changed")
+	}
+	set(benefit, body, "description", "metadata")
+	if _, ok := body["properties"]; !ok {
+		return nil
+	}
+	return setBenefitProperties(benefit, body)
+}
+
+// setBenefitProperties stores the body's properties with the read-only
+// fields and defaults Polar answers with for the benefit's type.
+func setBenefitProperties(benefit, body map[string]any) error {
+	properties, _ := cloneValue(body["properties"]).(map[string]any)
+	if properties == nil {
+		properties = map[string]any{}
+	}
+	defaults := map[string]any{}
+	switch benefit["type"] {
+	case "custom":
+		defaults["note"] = nil
+	case "discord":
+		token, _ := properties["guild_token"].(string)
+		defaults["guild_id"] = "guild_" + token
+	case "github_repository":
+	case "downloadables":
+		defaults["archived"] = map[string]any{}
+		defaults["files"] = []any{}
+	case "license_keys":
+		defaults["prefix"], defaults["expires"], defaults["activations"], defaults["limit_usage"] = nil, nil, nil, nil
+	case "meter_credit":
+	default:
+		return invalid("type", "unsupported benefit type %v", benefit["type"])
+	}
+	for key, value := range defaults {
+		if _, ok := properties[key]; !ok {
+			properties[key] = value
+		}
+	}
+	benefit["properties"] = properties
+	return nil
+}
+
+// --- Webhook endpoints ---
+
+func createWebhookEndpoint(s *Server, id string, body map[string]any) (map[string]any, error) {
+	for _, field := range []string{"url", "format"} {
+		if body[field] == nil {
+			return nil, invalid(field, "field required")
+		}
+	}
+	endpoint := map[string]any{
+		"secret":  newWebhookSecret(),
+		"events":  []any{},
+		"enabled": true,
+	}
+	set(endpoint, body, "url", "format", "events", "secret")
+	return endpoint, nil
+}
+
+func updateWebhookEndpoint(s *Server, endpoint, body map[string]any) error {
+	set(endpoint, body, "url", "format", "events", "secret", "enabled")
+	return nil
+}
+
+// handleResetWebhookSecret answers PATCH /v1/webhooks/endpoints/{id}/secret
+// by replacing the endpoint's secret with a new random one.
+func (s *Server) handleResetWebhookSecret(webhooks *kind) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		o := s.lookup(webhooks, r.PathValue("id"))
+		if o == nil || o.current == nil {
+			writeNotFound(w, webhooks)
+			return
+		}
+		endpoint := clone(o.current)
+		endpoint["secret"] = newWebhookSecret()
+		endpoint["modified_at"] = s.now()
+		s.write(o, endpoint)
+		writeJSON(w, http.StatusOK, endpoint)
+	}
+}
+
+// handleListWebhookDeliveries answers with no deliveries: the fake never
+// sends webhooks.
+func (s *Server) handleListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"items":      []any{},
+		"pagination": map[string]any{"total_count": 0, "max_page": 1},
+	})
+}
+
+// newWebhookSecret returns a random secret in Polar's "polar_whs_..." form.
+func newWebhookSecret() string {
+	b := make([]byte, 24)
+	_, _ = rand.Read(b)
+	return "polar_whs_" + hex.EncodeToString(b)
+}
+
+// --- Organizations ---
+
+// seedOrganization stores the organization the access token stands for,
+// with the settings a new Polar organization starts with. The settings
+// include fields polar-go doesn't model, which the provider reads and
+// writes with raw HTTP.
+func (s *Server) seedOrganization() {
+	s.insert(s.kinds["organization"], OrganizationID, map[string]any{
+		"id":                   OrganizationID,
+		"created_at":           s.now(),
+		"modified_at":          nil,
+		"name":                 "Polartest",
+		"slug":                 "polartest",
+		"avatar_url":           nil,
+		"email":                nil,
+		"website":              nil,
+		"socials":              []any{},
+		"status":               "active",
+		"details_submitted_at": nil,
+		"feature_settings": map[string]any{
+			"issue_funding_enabled":      false,
+			"seat_based_pricing_enabled": false,
+			"revops_enabled":             false,
+			"wallets_enabled":            false,
+		},
+		"subscription_settings": map[string]any{
+			"allow_multiple_subscriptions":    false,
+			"allow_customer_updates":          true,
+			"proration_behavior":              "prorate",
+			"benefit_revocation_grace_period": json.Number("0"),
+			"prevent_trial_abuse":             false,
+		},
+		"notification_settings": map[string]any{
+			"new_order":        true,
+			"new_subscription": true,
+		},
+		"customer_email_settings": map[string]any{
+			"order_confirmation":              true,
+			"subscription_cancellation":       true,
+			"subscription_confirmation":       true,
+			"subscription_cycled":             true,
+			"subscription_cycled_after_trial": true,
+			"subscription_past_due":           true,
+			"subscription_revoked":            true,
+			"subscription_uncanceled":         true,
+			"subscription_updated":            true,
+		},
+	})
+}
+
+// updateOrganization merges settings objects key by key, since the SDK and
+// the provider's raw HTTP PATCH each send only the settings they know.
+func updateOrganization(s *Server, org, body map[string]any) error {
+	if name, ok := body["name"]; ok {
+		if str, _ := name.(string); len(str) < 3 {
+			return invalid("name", "name must be at least 3 characters, got %s", strconv.Quote(str))
+		}
+	}
+	body = clone(body)
+	delete(body, "details") // write-only
+	mergeObject(org, body)
+	return nil
+}
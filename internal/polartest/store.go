@@ -0,0 +1,371 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package polartest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// object is one stored API object with its lag state.
+type object struct {
+	kind    string
+	current map[string]any // nil once deleted
+	// stale is what lagging reads see, nil reading as 404, for the next
+	// staleReads reads.
+	stale      map[string]any
+	staleReads int
+}
+
+// kind describes how one collection's objects are created and changed. The
+// create and update hooks run with the server lock held and return a
+// *validationError for bad input.
+type kind struct {
+	name string // singular, for 404 details, e.g. "product"
+	// create builds a new object from the request body. The id, timestamps
+	// and organization_id are filled in by the caller.
+	create func(s *Server, id string, body map[string]any) (map[string]any, error)
+	// update applies a PATCH body to a copy of the current object.
+	update func(s *Server, obj, body map[string]any) error
+	// render expands stored references for responses, or nil to answer
+	// with the object as stored.
+	render func(s *Server, obj map[string]any) map[string]any
+	// queryField is the field the list "query" parameter searches.
+	queryField string
+	// archived reports whether an object is archived, for the list
+	// is_archived filter, or nil when the collection can't be archived.
+	archived func(obj map[string]any) bool
+}
+
+// validationError is answered as a 422.
+type validationError struct {
+	field, msg string
+}
+
+func (e *validationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.field, e.msg)
+}
+
+func invalid(field, format string, args ...any) error {
+	return &validationError{field: field, msg: fmt.Sprintf(format, args...)}
+}
+
+// write replaces an object's state. With lag set, reads keep seeing the
+// state from before the first of any run of lagging writes.
+func (s *Server) write(o *object, next map[string]any) {
+	if s.lag > 0 {
+		if o.staleReads == 0 {
+			o.stale = o.current
+		}
+		o.staleReads = s.lag
+	}
+	o.current = next
+}
+
+// read returns the object as a GET sees it, honoring lag.
+func (s *Server) read(o *object) map[string]any {
+	if o.staleReads > 0 {
+		o.staleReads--
+		return o.stale
+	}
+	return o.current
+}
+
+func (s *Server) insert(k *kind, id string, obj map[string]any) {
+	o := &object{kind: k.name}
+	s.objects[id] = o
+	s.order = append(s.order, id)
+	s.write(o, obj)
+}
+
+// lookup returns the live object of kind k with the given ID, or nil.
+func (s *Server) lookup(k *kind, id string) *object {
+	o, ok := s.objects[id]
+	if !ok || o.kind != k.name {
+		return nil
+	}
+	return o
+}
+
+// current returns the current state of a live object of kind k, or nil.
+func (s *Server) current(k *kind, id string) map[string]any {
+	if o := s.lookup(k, id); o != nil {
+		return o.current
+	}
+	return nil
+}
+
+func (s *Server) render(kindName string, obj map[string]any) map[string]any {
+	if k := s.kinds[kindName]; k != nil && k.render != nil {
+		return k.render(s, obj)
+	}
+	return obj
+}
+
+// --- Generic handlers ---
+
+func (s *Server) handleCreate(k *kind) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, ok := decodeBody(w, r)
+		if !ok {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		id := s.newID()
+		obj, err := k.create(s, id, body)
+		if err != nil {
+			writeErr(w, err)
+			return
+		}
+		obj["id"] = id
+		obj["created_at"] = s.now()
+		obj["modified_at"] = nil
+		obj["organization_id"] = s.organizationFor(body)
+		s.insert(k, id, obj)
+		writeJSON(w, http.StatusCreated, s.render(k.name, obj))
+	}
+}
+
+func (s *Server) handleGet(k *kind) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		o := s.lookup(k, r.PathValue("id"))
+		var obj map[string]any
+		if o != nil {
+			obj = s.read(o)
+		}
+		if obj == nil {
+			writeNotFound(w, k)
+			return
+		}
+		writeJSON(w, http.StatusOK, s.render(k.name, obj))
+	}
+}
+
+func (s *Server) handleUpdate(k *kind) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, ok := decodeBody(w, r)
+		if !ok {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		o := s.lookup(k, r.PathValue("id"))
+		if o == nil || o.current == nil {
+			writeNotFound(w, k)
+			return
+		}
+		obj := clone(o.current)
+		if err := k.update(s, obj, body); err != nil {
+			writeErr(w, err)
+			return
+		}
+		obj["modified_at"] = s.now()
+		s.write(o, obj)
+		writeJSON(w, http.StatusOK, s.render(k.name, obj))
+	}
+}
+
+func (s *Server) handleDelete(k *kind) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		o := s.lookup(k, r.PathValue("id"))
+		if o == nil || o.current == nil {
+			writeNotFound(w, k)
+			return
+		}
+		s.write(o, nil)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleList answers a list request with the current objects of kind k that
+// match the query's filters, one page at a time.
+func (s *Server) handleList(k *kind) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		page, limit := intParam(query, "page", 1), intParam(query, "limit", 10)
+		if page < 1 || limit < 1 {
+			writeValidationError(w, "page", "page and limit must be positive")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		items := []any{}
+		for _, id := range s.order {
+			o := s.objects[id]
+			if o.kind != k.name || o.current == nil || !matchesQuery(k, o.current, query) {
+				continue
+			}
+			items = append(items, s.render(k.name, o.current))
+		}
+
+		total := len(items)
+		maxPage := max(1, (total+limit-1)/limit)
+		start, end := min((page-1)*limit, total), min(page*limit, total)
+		writeJSON(w, http.StatusOK, map[string]any{
+			"items":      items[start:end],
+			"pagination": map[string]any{"total_count": total, "max_page": maxPage},
+		})
+	}
+}
+
+// matchesQuery applies the list filters Polar's list endpoints share.
+func matchesQuery(k *kind, obj map[string]any, query url.Values) bool {
+	if ids := query["id"]; len(ids) > 0 && !contains(ids, obj["id"]) {
+		return false
+	}
+	if orgs := query["organization_id"]; len(orgs) > 0 && !contains(orgs, obj["organization_id"]) {
+		return false
+	}
+	if types := query["type"]; len(types) > 0 && !contains(types, obj["type"]) {
+		return false
+	}
+	if q := query.Get("query"); q != "" {
+		field, _ := obj[k.queryField].(string)
+		if !strings.Contains(strings.ToLower(field), strings.ToLower(q)) {
+			return false
+		}
+	}
+	if raw := query.Get("is_archived"); raw != "" && k.archived != nil {
+		if want, err := strconv.ParseBool(raw); err == nil && k.archived(obj) != want {
+			return false
+		}
+	}
+	if raw := query.Get("is_recurring"); raw != "" {
+		if want, err := strconv.ParseBool(raw); err == nil && obj["is_recurring"] != want {
+			return false
+		}
+	}
+	// Metadata filters arrive as deepObject parameters, metadata[key]=value,
+	// and match when the value equals any of the given ones.
+	metadata, _ := obj["metadata"].(map[string]any)
+	for param, values := range query {
+		key, ok := strings.CutPrefix(param, "metadata[")
+		if !ok || !strings.HasSuffix(key, "]") {
+			continue
+		}
+		value, ok := metadata[strings.TrimSuffix(key, "]")]
+		if !ok || !contains(values, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// contains reports whether v, rendered as a query parameter, is one of values.
+func contains(values []string, v any) bool {
+	var s string
+	switch v := v.(type) {
+	case nil:
+		return false
+	case string:
+		s = v
+	default:
+		s = fmt.Sprint(v)
+	}
+	for _, value := range values {
+		if value == s {
+			return true
+		}
+	}
+	return false
+}
+
+func intParam(query url.Values, name string, def int) int {
+	if n, err := strconv.Atoi(query.Get(name)); err == nil {
+		return n
+	}
+	return def
+}
+
+// decodeBody decodes a JSON object request body, answering 422 if it isn't one.
+// Numbers are kept as json.Number so they are echoed back exactly.
+func decodeBody(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	body := map[string]any{}
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		writeValidationError(w, "body", "invalid JSON object: "+err.Error())
+		return nil, false
+	}
+	return body, true
+}
+
+func writeErr(w http.ResponseWriter, err error) {
+	if v, ok := err.(*validationError); ok {
+		writeValidationError(w, v.field, v.msg)
+		return
+	}
+	writeError(w, http.StatusInternalServerError, "InternalServerError", err.Error())
+}
+
+func writeNotFound(w http.ResponseWriter, k *kind) {
+	writeError(w, http.StatusNotFound, "ResourceNotFound", k.name+" not found")
+}
+
+// clone deep-copies a JSON value.
+func clone(obj map[string]any) map[string]any {
+	if obj == nil {
+		return nil
+	}
+	return cloneValue(obj).(map[string]any)
+}
+
+func cloneValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, value := range v {
+			out[key] = cloneValue(value)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, value := range v {
+			out[i] = cloneValue(value)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// set copies the given fields from body to obj when present, replacing the
+// old value. An explicit null clears the field.
+func set(obj, body map[string]any, fields ...string) {
+	for _, field := range fields {
+		if value, ok := body[field]; ok {
+			obj[field] = cloneValue(value)
+		}
+	}
+}
+
+// mergeObject merges src into dst recursively: nested objects are merged
+// key by key, and anything else is replaced.
+func mergeObject(dst, src map[string]any) {
+	for key, value := range src {
+		nested, ok := value.(map[string]any)
+		existing, isObject := dst[key].(map[string]any)
+		if ok && isObject {
+			mergeObject(existing, nested)
+			continue
+		}
+		dst[key] = cloneValue(value)
+	}
+}
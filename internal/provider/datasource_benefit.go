@@ -6,30 +6,46 @@ import (
 	"context"
 	"fmt"
 
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/polarsource/polar-go"
+	"github.com/polarsource/polar-go/models/components"
+	"github.com/polarsource/polar-go/models/operations"
 )
 
-// Compile-time interface conformance check.
-var _ datasource.DataSource = &BenefitDataSource{}
+// Compile-time interface conformance checks.
+var (
+	_ datasource.DataSource                     = &BenefitDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &BenefitDataSource{}
+)
 
 func NewBenefitDataSource() datasource.DataSource {
 	return &BenefitDataSource{}
 }
 
-// BenefitDataSource is a read-only data source for looking up a benefit by ID.
-// Useful for referencing an unmanaged benefit (e.g. created in the dashboard)
-// from a managed product's benefit_ids.
+// BenefitDataSource is a read-only data source for looking up a benefit by ID,
+// by type and exact description, or by metadata. Useful for referencing an
+// unmanaged benefit (e.g. created in the dashboard) from a managed product's
+// benefit_ids.
 type BenefitDataSource struct {
-	client *polargo.Polar
+	client         *polargo.Polar
+	organizationID string
 }
 
+// BenefitDataSourceModel is the polar_benefit resource model plus the
+// lookup-only metadata filter.
 type BenefitDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Type        types.String `tfsdk:"type"`
-	Description types.String `tfsdk:"description"`
+	BenefitResourceModel
+	MetadataFilter types.Map `tfsdk:"metadata_filter"`
 }
 
 func (d *BenefitDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,79 +53,200 @@ func (d *BenefitDataSource) Metadata(ctx context.Context, req datasource.Metadat
 }
 
 func (d *BenefitDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Looks up a Polar benefit by ID. Use this to reference an unmanaged benefit (e.g. created in the dashboard) from a managed product's `benefit_ids`.",
-
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "The benefit ID.",
-				Required:            true,
-			},
-			"type": schema.StringAttribute{
-				MarkdownDescription: "The benefit type (`custom`, `discord`, `github_repository`, `downloadables`, `license_keys`, `meter_credit`).",
-				Computed:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "The description of the benefit.",
-				Computed:            true,
-			},
+	attrs := computedAttributes(resourceSchemaAttributes(ctx, NewBenefitResource()))
+	attrs["id"] = schema.StringAttribute{
+		MarkdownDescription: "The benefit ID. Conflicts with the other lookup attributes.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(
+				path.MatchRoot("type"),
+				path.MatchRoot("description"),
+				path.MatchRoot("metadata_filter"),
+				path.MatchRoot("organization_id"),
+			),
 		},
 	}
+	attrs["type"] = schema.StringAttribute{
+		MarkdownDescription: "The benefit type (`custom`, `discord`, `github_repository`, `downloadables`, `license_keys`, `meter_credit`). Required when looking up by `description`; optional with `metadata_filter`.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("custom", "discord", "github_repository", "downloadables", "license_keys", "meter_credit"),
+		},
+	}
+	attrs["description"] = schema.StringAttribute{
+		MarkdownDescription: "The exact description of the benefit. Must be combined with `type`.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("type")),
+		},
+	}
+	attrs["metadata_filter"] = schema.MapAttribute{
+		MarkdownDescription: "Look up the benefit whose metadata contains all of these key-value pairs. Can be combined with `type` and `description` to narrow the lookup.",
+		Optional:            true,
+		ElementType:         types.StringType,
+	}
+
+	attrs["organization_id"] = organizationIDFilterAttribute("benefits")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Polar benefit by ID, by `type` and exact `description`, or by `metadata_filter`, including its metadata and type-specific properties. Lookups other than by ID fail unless exactly one benefit matches. Use this to reference an unmanaged benefit (e.g. created in the dashboard) from a managed product's `benefit_ids`.",
+		Attributes:          attrs,
+	}
+}
+
+func (d *BenefitDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("description"),
+			path.MatchRoot("metadata_filter"),
+		),
+	}
 }
 
 func (d *BenefitDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
 		d.client = pd.Client
+		d.organizationID = pd.OrganizationID
 	}
 }
 
-// Read fetches the benefit and maps the active union variant to type + description.
+// Read fetches the benefit by ID or by a filtered list lookup, then maps it
+// with the resource mapper.
 func (d *BenefitDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = withCorrelationID(ctx)
 	var data BenefitDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	result, err := d.client.Benefits.Get(ctx, data.ID.ValueString())
-	if err != nil {
-		if isNotFound(err) {
+	var b *components.Benefit
+	if !data.ID.IsNull() {
+		result, err := d.client.Benefits.Get(ctx, data.ID.ValueString())
+		if err != nil {
+			if isNotFound(err) {
+				resp.Diagnostics.AddError(
+					"Benefit not found",
+					fmt.Sprintf("No benefit found with ID %s.", data.ID.ValueString()),
+				)
+				return
+			}
 			resp.Diagnostics.AddError(
-				"Benefit not found",
-				fmt.Sprintf("No benefit found with ID %s.", data.ID.ValueString()),
+				"Error reading benefit",
+				fmt.Sprintf("Could not read benefit %s: %s", data.ID.ValueString(), err),
 			)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Error reading benefit",
-			fmt.Sprintf("Could not read benefit %s: %s", data.ID.ValueString(), err),
+		b = result.Benefit
+	} else {
+		data.OrganizationID = resolveOrganizationID(data.OrganizationID, d.organizationID)
+		b = findBenefit(ctx, d.client, &data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	mapBenefitResponseToState(ctx, b, &data.BenefitResourceModel, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findBenefit looks up the single benefit matching the data source's type,
+// description and metadata filter.
+func findBenefit(ctx context.Context, client *polargo.Polar, data *BenefitDataSourceModel, diags *diag.Diagnostics) *components.Benefit {
+	matches := listBenefits(ctx, client, data, diags)
+	if diags.HasError() {
+		return nil
+	}
+	return singleMatch(matches, "benefit", benefitLookupCriteria(ctx, data, diags), diags)
+}
+
+// listBenefits lists benefits filtered server-side by type, then keeps exact
+// description and metadata matches only, since the API query is a substring
+// search. It's shared with the polar_benefit resource's import by lookup.
+func listBenefits(ctx context.Context, client *polargo.Polar, data *BenefitDataSourceModel, diags *diag.Diagnostics) []components.Benefit {
+	metadata := metadataFilterValues(ctx, data.MetadataFilter, diags)
+	limit := listPageSize
+	listReq := operations.BenefitsListRequest{
+		Query:          optionalStringPointer(data.Description),
+		OrganizationID: organizationIDFilter(data.OrganizationID, operations.CreateQueryParamOrganizationIDFilterStr),
+		Limit:          &limit,
+	}
+	if diags.HasError() {
+		return nil
+	}
+	if !data.Type.IsNull() {
+		typeFilter := operations.CreateBenefitTypeFilterBenefitType(components.BenefitType(data.Type.ValueString()))
+		listReq.TypeFilter = &typeFilter
+	}
+
+	var matches []components.Benefit
+	page, err := client.Benefits.List(ctx, listReq)
+	for err == nil && page != nil {
+		if page.ListResourceBenefit != nil {
+			for _, b := range page.ListResourceBenefit.Items {
+				if (data.Description.IsNull() || benefitDescription(b) == data.Description.ValueString()) && matchesMetadata(b, metadata) {
+					matches = append(matches, b)
+				}
+			}
+		}
+		page, err = page.Next()
+	}
+	if err != nil {
+		diags.AddError(
+			"Error listing benefits",
+			fmt.Sprintf("Could not search benefits: %s", err),
 		)
-		return
+		return nil
 	}
+	return matches
+}
 
-	// Benefit response is a union — check which variant is populated.
-	b := result.Benefit
-	data.ID = types.StringValue(benefitID(*b))
+// benefitLookupCriteria describes a non-ID lookup for error messages,
+// e.g. `type "custom", description "Support" and metadata {"team" = "growth"}`.
+func benefitLookupCriteria(ctx context.Context, data *BenefitDataSourceModel, diags *diag.Diagnostics) string {
+	var parts []string
+	if !data.Type.IsNull() {
+		parts = append(parts, fmt.Sprintf("type %q", data.Type.ValueString()))
+	}
+	if !data.Description.IsNull() {
+		parts = append(parts, fmt.Sprintf("description %q", data.Description.ValueString()))
+	}
+	if !data.MetadataFilter.IsNull() {
+		var values map[string]string
+		diags.Append(data.MetadataFilter.ElementsAs(ctx, &values, false)...)
+		pairs := make([]string, 0, len(values))
+		for k, v := range values {
+			pairs = append(pairs, fmt.Sprintf("%q = %q", k, v))
+		}
+		sort.Strings(pairs)
+		parts = append(parts, fmt.Sprintf("metadata {%s}", strings.Join(pairs, ", ")))
+	}
+	if len(parts) <= 1 {
+		return strings.Join(parts, "")
+	}
+	return strings.Join(parts[:len(parts)-1], ", ") + " and " + parts[len(parts)-1]
+}
+
+// benefitDescription returns the description of whichever union variant is set.
+func benefitDescription(b components.Benefit) string {
 	switch {
 	case b.BenefitCustom != nil:
-		data.Type = types.StringValue("custom")
-		data.Description = types.StringValue(b.BenefitCustom.Description)
+		return b.BenefitCustom.Description
 	case b.BenefitDiscord != nil:
-		data.Type = types.StringValue("discord")
-		data.Description = types.StringValue(b.BenefitDiscord.Description)
+		return b.BenefitDiscord.Description
 	case b.BenefitGitHubRepository != nil:
-		data.Type = types.StringValue("github_repository")
-		data.Description = types.StringValue(b.BenefitGitHubRepository.Description)
+		return b.BenefitGitHubRepository.Description
 	case b.BenefitDownloadables != nil:
-		data.Type = types.StringValue("downloadables")
-		data.Description = types.StringValue(b.BenefitDownloadables.Description)
+		return b.BenefitDownloadables.Description
 	case b.BenefitLicenseKeys != nil:
-		data.Type = types.StringValue("license_keys")
-		data.Description = types.StringValue(b.BenefitLicenseKeys.Description)
+		return b.BenefitLicenseKeys.Description
 	case b.BenefitMeterCredit != nil:
-		data.Type = types.StringValue("meter_credit")
-		data.Description = types.StringValue(b.BenefitMeterCredit.Description)
+		return b.BenefitMeterCredit.Description
+	default:
+		return ""
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
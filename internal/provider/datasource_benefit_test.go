@@ -3,14 +3,20 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/sjkchang/terraform-provider-polar/internal/polartest"
 )
 
 func TestAccBenefitDataSource_custom(t *testing.T) {
@@ -32,10 +38,150 @@ func TestAccBenefitDataSource_custom(t *testing.T) {
 						tfjsonpath.New("description"),
 						knownvalue.StringExact(rName),
 					),
+					statecheck.ExpectKnownValue(
+						"data.polar_benefit.test",
+						tfjsonpath.New("custom_properties").AtMapKey("note"),
+						knownvalue.StringExact("Data source test note"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccBenefitDataSource_byDescriptionAndMetadata(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBenefitDataSourceLookupConfig(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"data.polar_benefit.by_description", tfjsonpath.New("id"),
+						"polar_benefit.test", tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+					statecheck.CompareValuePairs(
+						"data.polar_benefit.by_metadata", tfjsonpath.New("id"),
+						"polar_benefit.test", tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.polar_benefit.by_metadata",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact(rName),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestBenefitLookupCriteria(t *testing.T) {
+	ctx := context.Background()
+	metadata := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"team": types.StringValue("growth"),
+		"env":  types.StringValue("prod"),
+	})
+
+	tests := []struct {
+		name string
+		data BenefitDataSourceModel
+		want string
+	}{
+		{
+			name: "description and type",
+			data: BenefitDataSourceModel{
+				BenefitResourceModel: BenefitResourceModel{
+					Type:        types.StringValue("custom"),
+					Description: types.StringValue("Support"),
+				},
+				MetadataFilter: types.MapNull(types.StringType),
+			},
+			want: `type "custom" and description "Support"`,
+		},
+		{
+			name: "metadata only",
+			data: BenefitDataSourceModel{
+				BenefitResourceModel: BenefitResourceModel{
+					Type:        types.StringNull(),
+					Description: types.StringNull(),
+				},
+				MetadataFilter: metadata,
+			},
+			want: `metadata {"env" = "prod", "team" = "growth"}`,
+		},
+		{
+			name: "all criteria",
+			data: BenefitDataSourceModel{
+				BenefitResourceModel: BenefitResourceModel{
+					Type:        types.StringValue("custom"),
+					Description: types.StringValue("Support"),
 				},
+				MetadataFilter: metadata,
 			},
+			want: `type "custom", description "Support" and metadata {"env" = "prod", "team" = "growth"}`,
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := benefitLookupCriteria(ctx, &tt.data, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("benefitLookupCriteria() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestBenefitDataSource_metadataLookup looks benefits up by metadata through
+// the SDK against the polartest fake.
+func TestBenefitDataSource_metadataLookup(t *testing.T) {
+	ctx := context.Background()
+	server := polartest.NewServer()
+	defer server.Close()
+
+	t.Setenv("POLAR_SERVER", "")
+	t.Setenv("POLAR_ORGANIZATION_ID", "")
+	pd, diags := configureTestProvider(t, map[string]any{
+		"access_token": "polar_oat_test",
+		"base_url":     server.URL(),
 	})
+	if diags.HasError() {
+		t.Fatalf("Configure: %v", diags)
+	}
+	for description, metadata := range map[string]map[string]any{
+		"Support":          {"team": "growth", "env": "prod"},
+		"Support (dev)":    {"team": "growth", "env": "dev"},
+		"Priority support": {"team": "success", "tier": 3},
+	} {
+		body := map[string]any{"type": "custom", "description": description, "properties": map[string]any{}, "metadata": metadata}
+		if _, err := supplementalPost[map[string]any](ctx, pd.Supplemental, "/v1/benefits/", body); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		attrs map[string]any
+		want  string
+	}{
+		{attrs: map[string]any{"metadata_filter": map[string]any{"team": "growth", "env": "dev"}}, want: "Support (dev)"},
+		{attrs: map[string]any{"metadata_filter": map[string]any{"tier": "3"}}, want: "Priority support"},
+		{attrs: map[string]any{"description": "Support", "metadata_filter": map[string]any{"team": "growth"}}, want: "Support"},
+	}
+	for _, tt := range tests {
+		var data BenefitDataSourceModel
+		readTestDataSource(t, NewBenefitDataSource(), pd, tt.attrs, &data)
+		if got := data.Description.ValueString(); got != tt.want {
+			t.Errorf("lookup %v = %q, want %q", tt.attrs, got, tt.want)
+		}
+	}
 }
 
 // --- Config helpers ---
@@ -56,3 +202,35 @@ data "polar_benefit" "test" {
 }
 `, description)
 }
+
+func testAccBenefitDataSourceLookupConfig(description string) string {
+	return fmt.Sprintf(`
+resource "polar_benefit" "test" {
+  type        = "custom"
+  description = %[1]q
+
+  custom_properties = {
+    note = "Data source test note"
+  }
+
+  metadata = {
+    lookup_key = %[1]q
+  }
+}
+
+data "polar_benefit" "by_description" {
+  type        = "custom"
+  description = %[1]q
+
+  depends_on = [polar_benefit.test]
+}
+
+data "polar_benefit" "by_metadata" {
+  metadata_filter = {
+    lookup_key = %[1]q
+  }
+
+  depends_on = [polar_benefit.test]
+}
+`, description)
+}
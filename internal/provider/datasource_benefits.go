@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/polarsource/polar-go"
+	"github.com/polarsource/polar-go/models/components"
+	"github.com/polarsource/polar-go/models/operations"
+)
+
+// Compile-time interface conformance check.
+var _ datasource.DataSource = &BenefitsDataSource{}
+
+func NewBenefitsDataSource() datasource.DataSource {
+	return &BenefitsDataSource{}
+}
+
+// BenefitsDataSource lists benefits matching optional filters. Each item has
+// the same shape as the polar_benefit resource.
+type BenefitsDataSource struct {
+	client         *polargo.Polar
+	organizationID string
+}
+
+type BenefitsDataSourceModel struct {
+	Query          types.String           `tfsdk:"query"`
+	Type           types.String           `tfsdk:"type"`
+	Metadata       types.Map              `tfsdk:"metadata"`
+	OrganizationID types.String           `tfsdk:"organization_id"`
+	Benefits       []BenefitResourceModel `tfsdk:"benefits"`
+}
+
+func (d *BenefitsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_benefits"
+}
+
+func (d *BenefitsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Polar benefits, optionally filtered by description, type and metadata. Benefits can't be archived, so there is no archived filter.",
+
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Only return benefits whose description matches this search query.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return benefits of this type. Must be one of: `custom`, `discord`, `github_repository`, `downloadables`, `license_keys`, `meter_credit`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("custom", "discord", "github_repository", "downloadables", "license_keys", "meter_credit"),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Only return benefits whose metadata contains all of these key-value pairs.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"organization_id": organizationIDFilterAttribute("benefits"),
+			"benefits": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching benefits.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: computedAttributes(resourceSchemaAttributes(ctx, NewBenefitResource())),
+				},
+			},
+		},
+	}
+}
+
+func (d *BenefitsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
+		d.client = pd.Client
+		d.organizationID = pd.OrganizationID
+	}
+}
+
+// Read pages through the benefits list endpoint and maps every match.
+func (d *BenefitsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = withCorrelationID(ctx)
+	var data BenefitsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrganizationID = resolveOrganizationID(data.OrganizationID, d.organizationID)
+	metadata := metadataFilterValues(ctx, data.Metadata, &resp.Diagnostics)
+	limit := listPageSize
+	listReq := operations.BenefitsListRequest{
+		Query:          optionalStringPointer(data.Query),
+		OrganizationID: organizationIDFilter(data.OrganizationID, operations.CreateQueryParamOrganizationIDFilterStr),
+		Limit:          &limit,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.Type.IsNull() && !data.Type.IsUnknown() {
+		typeFilter := operations.CreateBenefitTypeFilterBenefitType(components.BenefitType(data.Type.ValueString()))
+		listReq.TypeFilter = &typeFilter
+	}
+
+	data.Benefits = make([]BenefitResourceModel, 0)
+	page, err := d.client.Benefits.List(ctx, listReq)
+	for err == nil && page != nil {
+		if page.ListResourceBenefit != nil {
+			for i := range page.ListResourceBenefit.Items {
+				if !matchesMetadata(page.ListResourceBenefit.Items[i], metadata) {
+					continue
+				}
+				var benefit BenefitResourceModel
+				mapBenefitResponseToState(ctx, &page.ListResourceBenefit.Items[i], &benefit, &resp.Diagnostics)
+				data.Benefits = append(data.Benefits, benefit)
+			}
+		}
+		page, err = page.Next()
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing benefits",
+			fmt.Sprintf("Could not list benefits: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
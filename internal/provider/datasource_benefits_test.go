@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccBenefitsDataSource_typeAndMetadata(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBenefitsDataSourceConfig(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.polar_benefits.test",
+						tfjsonpath.New("benefits"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.polar_benefits.test",
+						tfjsonpath.New("benefits").AtSliceIndex(0).AtMapKey("license_keys_properties").AtMapKey("prefix"),
+						knownvalue.StringExact("TFACC"),
+					),
+				},
+			},
+		},
+	})
+}
+
+// --- Config helpers ---
+
+// testAccBenefitsDataSourceConfig creates a custom and a license-keys benefit
+// sharing a metadata tag, then lists only the license-keys one.
+func testAccBenefitsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "polar_benefit" "custom" {
+  type        = "custom"
+  description = "%[1]s-custom"
+
+  custom_properties = {
+    note = "Not a license key"
+  }
+
+  metadata = {
+    tf_acc = %[1]q
+  }
+}
+
+resource "polar_benefit" "license" {
+  type        = "license_keys"
+  description = "%[1]s-license"
+
+  license_keys_properties = {
+    prefix = "TFACC"
+  }
+
+  metadata = {
+    tf_acc = %[1]q
+  }
+}
+
+data "polar_benefits" "test" {
+  type = "license_keys"
+  metadata = {
+    tf_acc = %[1]q
+  }
+
+  depends_on = [polar_benefit.custom, polar_benefit.license]
+}
+`, name)
+}
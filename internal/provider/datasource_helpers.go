@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listPageSize is the page size used when paging through list endpoints.
+// 100 is the maximum the Polar API accepts.
+const listPageSize int64 = 100
+
+// --- Schema reuse ---
+// Data sources return the same object shapes as the matching resources, so
+// their schemas are derived from the resource schemas rather than duplicated.
+// This keeps the resource models and mappers usable as-is for data sources.
+
+// resourceSchemaAttributes returns the top-level attributes of a resource's
+// schema, minus resource-only settings that don't describe the object.
+func resourceSchemaAttributes(ctx context.Context, r resource.Resource) map[string]rschema.Attribute {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	delete(resp.Schema.Attributes, "consistency")
+	return resp.Schema.Attributes
+}
+
+// computedAttributes converts resource schema attributes to computed-only
+// data source attributes, recursing into nested attributes. Descriptions and
+// sensitivity are kept; validators, defaults and plan modifiers are dropped
+// since they only apply to configuration.
+func computedAttributes(attrs map[string]rschema.Attribute) map[string]schema.Attribute {
+	result := make(map[string]schema.Attribute, len(attrs))
+	for name, attr := range attrs {
+		result[name] = computedAttribute(attr)
+	}
+	return result
+}
+
+func computedAttribute(attr rschema.Attribute) schema.Attribute {
+	switch a := attr.(type) {
+	case rschema.StringAttribute:
+		return schema.StringAttribute{MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Computed: true}
+	case rschema.Int64Attribute:
+		return schema.Int64Attribute{MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Computed: true}
+	case rschema.Float64Attribute:
+		return schema.Float64Attribute{MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Computed: true}
+	case rschema.BoolAttribute:
+		return schema.BoolAttribute{MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, Computed: true}
+	case rschema.ListAttribute:
+		return schema.ListAttribute{MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, ElementType: a.ElementType, Computed: true}
+	case rschema.SetAttribute:
+		return schema.SetAttribute{MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, ElementType: a.ElementType, Computed: true}
+	case rschema.MapAttribute:
+		return schema.MapAttribute{MarkdownDescription: a.MarkdownDescription, Sensitive: a.Sensitive, ElementType: a.ElementType, Computed: true}
+	case rschema.SingleNestedAttribute:
+		return schema.SingleNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Sensitive:           a.Sensitive,
+			Attributes:          computedAttributes(a.Attributes),
+			Computed:            true,
+		}
+	case rschema.ListNestedAttribute:
+		return schema.ListNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Sensitive:           a.Sensitive,
+			NestedObject:        schema.NestedAttributeObject{Attributes: computedAttributes(a.NestedObject.Attributes)},
+			Computed:            true,
+		}
+	case rschema.SetNestedAttribute:
+		return schema.SetNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Sensitive:           a.Sensitive,
+			NestedObject:        schema.NestedAttributeObject{Attributes: computedAttributes(a.NestedObject.Attributes)},
+			Computed:            true,
+		}
+	default:
+		// Programming error: a resource schema gained an attribute kind this
+		// conversion doesn't know about. Caught by the data source schema tests.
+		panic(fmt.Sprintf("computedAttribute: unsupported attribute type %T", attr))
+	}
+}
+
+// --- List filters ---
+// The list endpoints take metadata filters as deepObject parameters
+// (metadata[key]=value), but polar-go v0.12.0 encodes the MetadataQuery union
+// as a Go struct instead of its value, so metadata filters are applied to the
+// listed objects rather than sent.
+
+// metadataFilterValues returns a metadata filter map's entries. Returns nil
+// when no filter is configured.
+func metadataFilterValues(ctx context.Context, metadata types.Map, diags *diag.Diagnostics) map[string]string {
+	if metadata.IsNull() || metadata.IsUnknown() {
+		return nil
+	}
+	var values map[string]string
+	diags.Append(metadata.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+// matchesMetadata reports whether obj, an SDK object with a metadata field,
+// has every key in filter with the same value. Values compare as they appear
+// in Terraform state (see sdkMetadataToMap), so {count = "3"} matches an
+// integer 3.
+func matchesMetadata(obj any, filter map[string]string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return false
+	}
+	var parsed struct {
+		Metadata map[string]any `json:"metadata"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&parsed); err != nil {
+		return false
+	}
+	for key, want := range filter {
+		value, ok := parsed.Metadata[key]
+		if !ok || metadataValueString(value) != want {
+			return false
+		}
+	}
+	return true
+}
+
+// metadataValueString formats a decoded metadata value the way
+// sdkMetadataToMap does.
+func metadataValueString(value any) string {
+	if n, ok := value.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			return strconv.FormatInt(i, 10)
+		}
+		if f, err := n.Float64(); err == nil {
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}
+	}
+	return fmt.Sprint(value)
+}
+
+// --- Lookups by attribute ---
+
+// singleMatch returns the only element of matches. Zero or multiple matches
+// are reported as errors naming the object kind and the lookup criteria
+// (e.g. `name "Pro"`), so users can tell a typo from an ambiguous lookup.
+func singleMatch[T any](matches []T, kind, criteria string, diags *diag.Diagnostics) *T {
+	switch len(matches) {
+	case 1:
+		return &matches[0]
+	case 0:
+		diags.AddError(
+			fmt.Sprintf("No matching %s", kind),
+			fmt.Sprintf("No %s found with %s.", kind, criteria),
+		)
+	default:
+		diags.AddError(
+			fmt.Sprintf("Multiple matching %ss", kind),
+			fmt.Sprintf("Found %d %ss with %s. Look it up by id instead, or use a more specific filter.", len(matches), kind, criteria),
+		)
+	}
+	return nil
+}
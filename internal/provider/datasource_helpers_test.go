@@ -0,0 +1,276 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/polarsource/polar-go/models/components"
+	"github.com/sjkchang/terraform-provider-polar/internal/polartest"
+)
+
+func TestComputedAttributes_allComputed(t *testing.T) {
+	ctx := context.Background()
+	for name, r := range map[string]resource.Resource{
+		"product": NewProductResource(),
+		"meter":   NewMeterResource(),
+		"benefit": NewBenefitResource(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			attrs := resourceSchemaAttributes(ctx, r)
+			if _, ok := attrs["consistency"]; ok {
+				t.Error("resource-only consistency attribute leaked into the data source schema")
+			}
+			assertAllComputed(t, name, computedAttributes(attrs))
+		})
+	}
+}
+
+func assertAllComputed(t *testing.T, path string, attrs map[string]schema.Attribute) {
+	t.Helper()
+	for name, a := range attrs {
+		p := path + "." + name
+		if !a.IsComputed() || a.IsOptional() || a.IsRequired() {
+			t.Errorf("%s: want computed-only attribute", p)
+		}
+		switch n := a.(type) {
+		case schema.SingleNestedAttribute:
+			assertAllComputed(t, p, n.Attributes)
+		case schema.ListNestedAttribute:
+			assertAllComputed(t, p, n.NestedObject.Attributes)
+		}
+	}
+}
+
+// TestDataSources_modelMatchesSchema sets mapped models into state built
+// from each data source schema. This catches drift between the derived
+// schemas and the reused resource models without hitting the API.
+func TestDataSources_modelMatchesSchema(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	product := components.Product{
+		ID:       "prod_1",
+		Name:     "Pro",
+		Metadata: map[string]components.ProductMetadata{"team": components.CreateProductMetadataStr("growth")},
+		Prices: []components.Prices{{ProductPrice: &components.ProductPrice{
+			ProductPriceFixed: &components.ProductPriceFixed{ID: "price_1", PriceAmount: 1000, PriceCurrency: "usd"},
+		}}},
+		Benefits: []components.Benefit{components.CreateBenefitBenefitCustom(components.BenefitCustom{ID: "ben_1"})},
+	}
+	meter := components.Meter{
+		ID:   "meter_1",
+		Name: "API Calls",
+		Filter: components.Filter{
+			Conjunction: "and",
+			Clauses:     []components.Clauses{testSDKFilterClause("name", "api_call")},
+		},
+		Aggregation: components.CreateMeterAggregationCount(components.CountAggregation{}),
+	}
+	prefix := "KEY"
+	benefit := components.CreateBenefitBenefitLicenseKeys(components.BenefitLicenseKeys{
+		ID:          "ben_2",
+		Description: "License",
+		Properties:  components.BenefitLicenseKeysProperties{Prefix: &prefix},
+	})
+
+	products := ProductsDataSourceModel{
+		Query:       types.StringNull(),
+		IsArchived:  types.BoolNull(),
+		IsRecurring: types.BoolNull(),
+		Metadata:    types.MapNull(types.StringType),
+		Products:    []ProductResourceModel{productModelFromSDK(ctx, &product, &diags)},
+	}
+	meters := MetersDataSourceModel{
+		Query:      types.StringNull(),
+		IsArchived: types.BoolNull(),
+		Metadata:   types.MapNull(types.StringType),
+	}
+	var meterModel MeterResourceModel
+	mapMeterResponseToState(ctx, &meter, &meterModel, &diags)
+	meters.Meters = []MeterResourceModel{meterModel}
+	benefits := BenefitsDataSourceModel{
+		Query:    types.StringNull(),
+		Type:     types.StringNull(),
+		Metadata: types.MapNull(types.StringType),
+	}
+	var benefitModel BenefitResourceModel
+	mapBenefitResponseToState(ctx, &benefit, &benefitModel, &diags)
+	benefits.Benefits = []BenefitResourceModel{benefitModel}
+	benefitLookup := BenefitDataSourceModel{
+		BenefitResourceModel: benefitModel,
+		MetadataFilter:       types.MapNull(types.StringType),
+	}
+
+	code := int64(200)
+	deliveries := WebhookDeliveriesDataSourceModel{
+		EndpointID:     types.StringValue("we_1"),
+		StartTimestamp: types.StringNull(),
+		EndTimestamp:   types.StringNull(),
+		MaxResults:     types.Int64Null(),
+	}
+	mapWebhookDeliveriesToState([]components.WebhookDelivery{{
+		ID:           "wd_1",
+		Succeeded:    true,
+		HTTPCode:     &code,
+		WebhookEvent: components.WebhookEvent{ID: "wev_1", Type: components.WebhookEventTypeOrderPaid},
+	}}, defaultWebhookDeliveriesMaxResults, &deliveries)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected mapping diagnostics: %v", diags)
+	}
+
+	for _, tt := range []struct {
+		name  string
+		ds    datasource.DataSource
+		model any
+	}{
+		{"products", NewProductsDataSource(), &products},
+		{"meters", NewMetersDataSource(), &meters},
+		{"benefits", NewBenefitsDataSource(), &benefits},
+		{"meter", NewMeterDataSource(), &meterModel},
+		{"benefit", NewBenefitDataSource(), &benefitLookup},
+		{"webhook deliveries", NewWebhookDeliveriesDataSource(), &deliveries},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			setDataSourceModel(t, tt.ds, tt.model)
+		})
+	}
+}
+
+// setDataSourceModel fails the test if model can't be stored in state built
+// from the data source's schema.
+func setDataSourceModel(t *testing.T, ds datasource.DataSource, model any) {
+	t.Helper()
+	ctx := context.Background()
+
+	var resp datasource.SchemaResponse
+	ds.Schema(ctx, datasource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema diagnostics: %v", resp.Diagnostics)
+	}
+	if d := resp.Schema.ValidateImplementation(ctx); d.HasError() {
+		t.Fatalf("invalid schema: %v", d)
+	}
+
+	state := tfsdk.State{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if d := state.Set(ctx, model); d.HasError() {
+		t.Fatalf("model does not match schema: %v", d)
+	}
+}
+
+// TestListDataSources_metadataFilter reads the list data sources through the
+// SDK against the polartest fake, since polar-go can't send metadata filters
+// and the data sources apply them to the listed objects instead.
+func TestListDataSources_metadataFilter(t *testing.T) {
+	ctx := context.Background()
+	server := polartest.NewServer()
+	defer server.Close()
+
+	t.Setenv("POLAR_SERVER", "")
+	t.Setenv("POLAR_ORGANIZATION_ID", "")
+	pd, diags := configureTestProvider(t, map[string]any{
+		"access_token": "polar_oat_test",
+		"base_url":     server.URL(),
+	})
+	if diags.HasError() {
+		t.Fatalf("Configure: %v", diags)
+	}
+
+	seed := func(path string, body map[string]any) {
+		t.Helper()
+		if _, err := supplementalPost[map[string]any](ctx, pd.Supplemental, path, body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, metadata := range map[string]map[string]any{
+		"Growth dev":  {"team": "growth", "env": "dev"},
+		"Growth prod": {"team": "growth", "env": "prod"},
+		"Tier 3":      {"team": "growth", "tier": 3},
+		"Untagged":    {},
+	} {
+		seed("/v1/products/", map[string]any{"name": name, "prices": []any{map[string]any{"amount_type": "free"}}, "metadata": metadata})
+		seed("/v1/meters/", map[string]any{
+			"name":        name,
+			"filter":      map[string]any{"conjunction": "and", "clauses": []any{}},
+			"aggregation": map[string]any{"func": "count"},
+			"metadata":    metadata,
+		})
+		seed("/v1/benefits/", map[string]any{"type": "custom", "description": name, "properties": map[string]any{}, "metadata": metadata})
+	}
+
+	tests := []struct {
+		metadata map[string]any
+		want     []string
+	}{
+		{metadata: nil, want: []string{"Growth dev", "Growth prod", "Tier 3", "Untagged"}},
+		{metadata: map[string]any{"team": "growth"}, want: []string{"Growth dev", "Growth prod", "Tier 3"}},
+		{metadata: map[string]any{"team": "growth", "env": "prod"}, want: []string{"Growth prod"}},
+		{metadata: map[string]any{"tier": "3"}, want: []string{"Tier 3"}},
+		{metadata: map[string]any{"team": "other"}, want: nil},
+	}
+	for _, tt := range tests {
+		var products ProductsDataSourceModel
+		readTestDataSource(t, NewProductsDataSource(), pd, map[string]any{"metadata": tt.metadata}, &products)
+		var productNames []string
+		for _, p := range products.Products {
+			productNames = append(productNames, p.Name.ValueString())
+		}
+
+		var meters MetersDataSourceModel
+		readTestDataSource(t, NewMetersDataSource(), pd, map[string]any{"metadata": tt.metadata}, &meters)
+		var meterNames []string
+		for _, m := range meters.Meters {
+			meterNames = append(meterNames, m.Name.ValueString())
+		}
+
+		var benefits BenefitsDataSourceModel
+		readTestDataSource(t, NewBenefitsDataSource(), pd, map[string]any{"metadata": tt.metadata}, &benefits)
+		var benefitNames []string
+		for _, b := range benefits.Benefits {
+			benefitNames = append(benefitNames, b.Description.ValueString())
+		}
+
+		for kind, got := range map[string][]string{"products": productNames, "meters": meterNames, "benefits": benefitNames} {
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("%s with metadata %v = %q, want %q", kind, tt.metadata, got, tt.want)
+			}
+		}
+	}
+}
+
+// readTestDataSource configures ds with pd, reads it with the given config
+// attributes set and the rest null, and decodes the resulting state into
+// model.
+func readTestDataSource(t *testing.T, ds datasource.DataSource, pd *PolarProviderData, attrs map[string]any, model any) {
+	t.Helper()
+	ctx := context.Background()
+
+	var configureResp datasource.ConfigureResponse
+	ds.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{ProviderData: pd}, &configureResp)
+
+	var schemaResp datasource.SchemaResponse
+	ds.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(ctx)
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)}}
+	ds.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: testTerraformValue(t, typ, attrs)}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
+	}
+	if d := resp.State.Get(ctx, model); d.HasError() {
+		t.Fatalf("decoding state: %v", d)
+	}
+}
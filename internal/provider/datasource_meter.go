@@ -6,10 +6,16 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/polarsource/polar-go"
+	"github.com/polarsource/polar-go/models/components"
+	"github.com/polarsource/polar-go/models/operations"
 )
 
 // Compile-time interface conformance check.
@@ -19,15 +25,12 @@ func NewMeterDataSource() datasource.DataSource {
 	return &MeterDataSource{}
 }
 
-// MeterDataSource is a read-only data source for looking up a meter by ID.
+// MeterDataSource is a read-only data source for looking up a meter by ID or exact name.
 // Useful for referencing an unmanaged meter in a metered-unit price or meter-credit benefit.
+// The result has the same shape as the polar_meter resource (MeterResourceModel).
 type MeterDataSource struct {
-	client *polargo.Polar
-}
-
-type MeterDataSourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	client         *polargo.Polar
+	organizationID string
 }
 
 func (d *MeterDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -35,52 +38,103 @@ func (d *MeterDataSource) Metadata(ctx context.Context, req datasource.MetadataR
 }
 
 func (d *MeterDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Looks up a Polar meter by ID. Use this to reference an unmanaged meter from a metered-unit price or meter-credit benefit.",
-
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "The meter ID.",
-				Required:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the meter.",
-				Computed:            true,
-			},
+	attrs := computedAttributes(resourceSchemaAttributes(ctx, NewMeterResource()))
+	attrs["id"] = schema.StringAttribute{
+		MarkdownDescription: "The meter ID. Exactly one of `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
 		},
 	}
+	attrs["name"] = schema.StringAttribute{
+		MarkdownDescription: "The exact meter name. Archived meters are ignored when looking up by name, and the lookup fails if the name is not unique.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	attrs["organization_id"] = organizationIDFilterAttribute("meters", stringvalidator.ConflictsWith(path.MatchRoot("id")))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Polar meter by ID or exact name, including its filter, aggregation and metadata. Use this to reference an unmanaged meter from a metered-unit price or meter-credit benefit.",
+		Attributes:          attrs,
+	}
 }
 
 func (d *MeterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
 		d.client = pd.Client
+		d.organizationID = pd.OrganizationID
 	}
 }
 
 func (d *MeterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data MeterDataSourceModel
+	ctx = withCorrelationID(ctx)
+	var data MeterResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	result, err := d.client.Meters.Get(ctx, data.ID.ValueString())
-	if err != nil {
-		if isNotFound(err) {
+	var meter *components.Meter
+	if !data.ID.IsNull() {
+		result, err := d.client.Meters.Get(ctx, data.ID.ValueString())
+		if err != nil {
+			if isNotFound(err) {
+				resp.Diagnostics.AddError(
+					"Meter not found",
+					fmt.Sprintf("No meter found with ID %s.", data.ID.ValueString()),
+				)
+				return
+			}
 			resp.Diagnostics.AddError(
-				"Meter not found",
-				fmt.Sprintf("No meter found with ID %s.", data.ID.ValueString()),
+				"Error reading meter",
+				fmt.Sprintf("Could not read meter %s: %s", data.ID.ValueString(), err),
 			)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Error reading meter",
-			fmt.Sprintf("Could not read meter %s: %s", data.ID.ValueString(), err),
-		)
-		return
+		meter = result.Meter
+	} else {
+		data.OrganizationID = resolveOrganizationID(data.OrganizationID, d.organizationID)
+		meter = findMeter(ctx, d.client, objectLookup{Name: data.Name.ValueString()}, data.OrganizationID, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	data.ID = types.StringValue(result.Meter.ID)
-	data.Name = types.StringValue(result.Meter.Name)
+	mapMeterResponseToState(ctx, meter, &data, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// findMeter searches active meters by name, metadata or both and keeps exact
+// matches only, since the API query is a substring search. It's shared with
+// the polar_meter resource's import by lookup.
+func findMeter(ctx context.Context, client *polargo.Polar, lookup objectLookup, organizationID types.String, diags *diag.Diagnostics) *components.Meter {
+	limit := listPageSize
+	isArchived := false
+	var matches []components.Meter
+	page, err := client.Meters.List(ctx, operations.MetersListRequest{
+		Query:          lookup.query(),
+		IsArchived:     &isArchived,
+		OrganizationID: organizationIDFilter(organizationID, operations.CreateMetersListQueryParamOrganizationIDFilterStr),
+		Limit:          &limit,
+	})
+	for err == nil && page != nil {
+		if page.ListResourceMeter != nil {
+			for _, m := range page.ListResourceMeter.Items {
+				if lookup.matches(m.Name, m) {
+					matches = append(matches, m)
+				}
+			}
+		}
+		page, err = page.Next()
+	}
+	if err != nil {
+		diags.AddError(
+			"Error listing meters",
+			fmt.Sprintf("Could not search meters: %s", err),
+		)
+		return nil
+	}
+	return singleMatch(matches, "meter", lookup.criteria("name"), diags)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -27,12 +28,44 @@ func TestAccMeterDataSource_basic(t *testing.T) {
 						tfjsonpath.New("name"),
 						knownvalue.StringExact(rName),
 					),
+					statecheck.ExpectKnownValue(
+						"data.polar_meter.test",
+						tfjsonpath.New("filter").AtMapKey("clauses").AtSliceIndex(0).AtMapKey("value"),
+						knownvalue.StringExact("test"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.polar_meter.test",
+						tfjsonpath.New("aggregation").AtMapKey("func"),
+						knownvalue.StringExact("count"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccMeterDataSource_byName(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeterDataSourceByNameConfig(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"data.polar_meter.test", tfjsonpath.New("id"),
+						"polar_meter.test", tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
 				},
 			},
 		},
 	})
 }
 
+// --- Config helpers ---
+
 func testAccMeterDataSourceConfig(name string) string {
 	return fmt.Sprintf(`
 resource "polar_meter" "test" {
@@ -57,3 +90,30 @@ data "polar_meter" "test" {
 }
 `, name)
 }
+
+func testAccMeterDataSourceByNameConfig(name string) string {
+	return fmt.Sprintf(`
+resource "polar_meter" "test" {
+  name = %[1]q
+
+  filter = {
+    conjunction = "and"
+    clauses = [{
+      property = "name"
+      operator = "eq"
+      value    = "test"
+    }]
+  }
+
+  aggregation = {
+    func = "count"
+  }
+}
+
+data "polar_meter" "test" {
+  name = %[1]q
+
+  depends_on = [polar_meter.test]
+}
+`, name)
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/polarsource/polar-go"
+	"github.com/polarsource/polar-go/models/operations"
+)
+
+// Compile-time interface conformance check.
+var _ datasource.DataSource = &MetersDataSource{}
+
+func NewMetersDataSource() datasource.DataSource {
+	return &MetersDataSource{}
+}
+
+// MetersDataSource lists meters matching optional filters. Each item has the
+// same shape as the polar_meter resource.
+type MetersDataSource struct {
+	client         *polargo.Polar
+	organizationID string
+}
+
+type MetersDataSourceModel struct {
+	Query          types.String         `tfsdk:"query"`
+	IsArchived     types.Bool           `tfsdk:"is_archived"`
+	Metadata       types.Map            `tfsdk:"metadata"`
+	OrganizationID types.String         `tfsdk:"organization_id"`
+	Meters         []MeterResourceModel `tfsdk:"meters"`
+}
+
+func (d *MetersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_meters"
+}
+
+func (d *MetersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Polar meters, optionally filtered by name, archived status and metadata.",
+
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Only return meters whose name matches this search query.",
+				Optional:            true,
+			},
+			"is_archived": schema.BoolAttribute{
+				MarkdownDescription: "Only return archived (`true`) or active (`false`) meters. Omit to return both.",
+				Optional:            true,
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Only return meters whose metadata contains all of these key-value pairs.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"organization_id": organizationIDFilterAttribute("meters"),
+			"meters": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching meters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: computedAttributes(resourceSchemaAttributes(ctx, NewMeterResource())),
+				},
+			},
+		},
+	}
+}
+
+func (d *MetersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
+		d.client = pd.Client
+		d.organizationID = pd.OrganizationID
+	}
+}
+
+// Read pages through the meters list endpoint and maps every match.
+func (d *MetersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = withCorrelationID(ctx)
+	var data MetersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrganizationID = resolveOrganizationID(data.OrganizationID, d.organizationID)
+	metadata := metadataFilterValues(ctx, data.Metadata, &resp.Diagnostics)
+	limit := listPageSize
+	listReq := operations.MetersListRequest{
+		Query:          optionalStringPointer(data.Query),
+		IsArchived:     optionalBoolPointer(data.IsArchived),
+		OrganizationID: organizationIDFilter(data.OrganizationID, operations.CreateMetersListQueryParamOrganizationIDFilterStr),
+		Limit:          &limit,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Meters = make([]MeterResourceModel, 0)
+	page, err := d.client.Meters.List(ctx, listReq)
+	for err == nil && page != nil {
+		if page.ListResourceMeter != nil {
+			for i := range page.ListResourceMeter.Items {
+				if !matchesMetadata(page.ListResourceMeter.Items[i], metadata) {
+					continue
+				}
+				var meter MeterResourceModel
+				mapMeterResponseToState(ctx, &page.ListResourceMeter.Items[i], &meter, &resp.Diagnostics)
+				data.Meters = append(data.Meters, meter)
+			}
+		}
+		page, err = page.Next()
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing meters",
+			fmt.Sprintf("Could not list meters: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccMetersDataSource_query(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetersDataSourceConfig(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.polar_meters.test",
+						tfjsonpath.New("meters"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.polar_meters.test",
+						tfjsonpath.New("meters").AtSliceIndex(0).AtMapKey("aggregation").AtMapKey("func"),
+						knownvalue.StringExact("count"),
+					),
+				},
+			},
+		},
+	})
+}
+
+// --- Config helpers ---
+
+func testAccMetersDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "polar_meter" "test" {
+  name = %[1]q
+
+  filter = {
+    conjunction = "and"
+    clauses = [{
+      property = "name"
+      operator = "eq"
+      value    = "test"
+    }]
+  }
+
+  aggregation = {
+    func = "count"
+  }
+}
+
+data "polar_meters" "test" {
+  query       = %[1]q
+  is_archived = false
+
+  depends_on = [polar_meter.test]
+}
+`, name)
+}
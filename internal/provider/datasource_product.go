@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/polarsource/polar-go"
+	"github.com/polarsource/polar-go/models/components"
+	"github.com/polarsource/polar-go/models/operations"
+)
+
+// Compile-time interface conformance check.
+var _ datasource.DataSource = &ProductDataSource{}
+
+func NewProductDataSource() datasource.DataSource {
+	return &ProductDataSource{}
+}
+
+// ProductDataSource looks up a single product by ID or exact name. The result
+// has the same shape as the polar_product resource (ProductResourceModel).
+type ProductDataSource struct {
+	client         *polargo.Polar
+	organizationID string
+}
+
+func (d *ProductDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product"
+}
+
+func (d *ProductDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := computedAttributes(resourceSchemaAttributes(ctx, NewProductResource()))
+	attrs["id"] = schema.StringAttribute{
+		MarkdownDescription: "The product ID. Exactly one of `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attrs["name"] = schema.StringAttribute{
+		MarkdownDescription: "The exact product name. Archived products are ignored when looking up by name, and the lookup fails if the name is not unique.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	attrs["organization_id"] = organizationIDFilterAttribute("products", stringvalidator.ConflictsWith(path.MatchRoot("id")))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a Polar product by ID or exact name. Use this to reference an unmanaged product from a checkout link, discount or benefit configuration.",
+		Attributes:          attrs,
+	}
+}
+
+func (d *ProductDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
+		d.client = pd.Client
+		d.organizationID = pd.OrganizationID
+	}
+}
+
+func (d *ProductDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = withCorrelationID(ctx)
+	var data ProductResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var product *components.Product
+	if !data.ID.IsNull() {
+		result, err := d.client.Products.Get(ctx, data.ID.ValueString())
+		if err != nil {
+			if isNotFound(err) {
+				resp.Diagnostics.AddError(
+					"Product not found",
+					fmt.Sprintf("No product found with ID %s.", data.ID.ValueString()),
+				)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error reading product",
+				fmt.Sprintf("Could not read product %s: %s", data.ID.ValueString(), err),
+			)
+			return
+		}
+		product = result.Product
+	} else {
+		data.OrganizationID = resolveOrganizationID(data.OrganizationID, d.organizationID)
+		product = findProduct(ctx, d.client, objectLookup{Name: data.Name.ValueString()}, data.OrganizationID, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data = productModelFromSDK(ctx, product, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findProduct searches active products by name, metadata or both and keeps exact
+// matches only, since the API query is a substring search. It's shared with
+// the polar_product resource's import by lookup.
+func findProduct(ctx context.Context, client *polargo.Polar, lookup objectLookup, organizationID types.String, diags *diag.Diagnostics) *components.Product {
+	limit := listPageSize
+	isArchived := false
+	var matches []components.Product
+	page, err := client.Products.List(ctx, operations.ProductsListRequest{
+		Query:          lookup.query(),
+		IsArchived:     &isArchived,
+		OrganizationID: organizationIDFilter(organizationID, operations.CreateProductsListQueryParamOrganizationIDFilterStr),
+		Limit:          &limit,
+	})
+	for err == nil && page != nil {
+		if page.ListResourceProduct != nil {
+			for _, p := range page.ListResourceProduct.Items {
+				if lookup.matches(p.Name, p) {
+					matches = append(matches, p)
+				}
+			}
+		}
+		page, err = page.Next()
+	}
+	if err != nil {
+		diags.AddError(
+			"Error listing products",
+			fmt.Sprintf("Could not search products: %s", err),
+		)
+		return nil
+	}
+	return singleMatch(matches, "product", lookup.criteria("name"), diags)
+}
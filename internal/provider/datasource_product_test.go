@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/polarsource/polar-go/models/components"
+)
+
+func TestAccProductDataSource_byIDAndName(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProductDataSourceConfig(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.polar_product.by_id",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(rName),
+					),
+					statecheck.ExpectKnownValue(
+						"data.polar_product.by_id",
+						tfjsonpath.New("recurring_interval"),
+						knownvalue.StringExact("month"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.polar_product.by_id",
+						tfjsonpath.New("prices").AtSliceIndex(0).AtMapKey("price_amount"),
+						knownvalue.Int64Exact(1500),
+					),
+					statecheck.ExpectKnownValue(
+						"data.polar_product.by_id",
+						tfjsonpath.New("benefit_ids"),
+						knownvalue.SetSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.polar_product.by_name",
+						tfjsonpath.New("is_archived"),
+						knownvalue.Bool(false),
+					),
+					statecheck.ExpectKnownValue(
+						"data.polar_product.by_name",
+						tfjsonpath.New("metadata").AtMapKey("team"),
+						knownvalue.StringExact("growth"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestProductDataSource_modelMatchesSchema(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	product := components.Product{
+		ID:   "prod_1",
+		Name: "Pro",
+		Prices: []components.Prices{{ProductPrice: &components.ProductPrice{
+			ProductPriceFree: &components.ProductPriceFree{ID: "price_1"},
+		}}},
+	}
+	model := productModelFromSDK(ctx, &product, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected mapping diagnostics: %v", diags)
+	}
+	setDataSourceModel(t, NewProductDataSource(), &model)
+}
+
+func TestSingleMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		matches []string
+		wantErr string
+	}{
+		{"one match", []string{"a"}, ""},
+		{"no match", nil, "No matching product"},
+		{"ambiguous", []string{"a", "b"}, "Multiple matching products"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := singleMatch(tt.matches, "product", `name "Pro"`, &diags)
+			if tt.wantErr == "" {
+				if diags.HasError() || got == nil || *got != tt.matches[0] {
+					t.Errorf("singleMatch() = %v, %v; want %q", got, diags, tt.matches[0])
+				}
+				return
+			}
+			if got != nil || !diags.HasError() || diags.Errors()[0].Summary() != tt.wantErr {
+				t.Errorf("singleMatch() = %v, %v; want error %q", got, diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+// --- Config helpers ---
+
+func testAccProductDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "polar_benefit" "test" {
+  type        = "custom"
+  description = %[1]q
+
+  custom_properties = {
+    note = "Data source test note"
+  }
+}
+
+resource "polar_product" "test" {
+  name               = %[1]q
+  recurring_interval = "month"
+
+  prices = [{
+    amount_type  = "fixed"
+    price_amount = 1500
+  }]
+
+  benefit_ids = [polar_benefit.test.id]
+
+  metadata = {
+    team = "growth"
+  }
+}
+
+data "polar_product" "by_id" {
+  id = polar_product.test.id
+}
+
+# The name is known at plan time, so depends_on defers the lookup until
+# the product exists.
+data "polar_product" "by_name" {
+  name = %[1]q
+
+  depends_on = [polar_product.test]
+}
+`, name)
+}
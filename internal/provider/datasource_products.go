@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/polarsource/polar-go"
+	"github.com/polarsource/polar-go/models/components"
+	"github.com/polarsource/polar-go/models/operations"
+)
+
+// Compile-time interface conformance check.
+var _ datasource.DataSource = &ProductsDataSource{}
+
+func NewProductsDataSource() datasource.DataSource {
+	return &ProductsDataSource{}
+}
+
+// ProductsDataSource lists products matching optional filters. Each item has
+// the same shape as the polar_product resource.
+type ProductsDataSource struct {
+	client         *polargo.Polar
+	organizationID string
+}
+
+type ProductsDataSourceModel struct {
+	Query          types.String           `tfsdk:"query"`
+	IsArchived     types.Bool             `tfsdk:"is_archived"`
+	IsRecurring    types.Bool             `tfsdk:"is_recurring"`
+	Metadata       types.Map              `tfsdk:"metadata"`
+	OrganizationID types.String           `tfsdk:"organization_id"`
+	Products       []ProductResourceModel `tfsdk:"products"`
+}
+
+func (d *ProductsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_products"
+}
+
+func (d *ProductsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Polar products, optionally filtered by name, archived status, billing type and metadata. Use this to discover products managed outside the current configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Only return products whose name matches this search query.",
+				Optional:            true,
+			},
+			"is_archived": schema.BoolAttribute{
+				MarkdownDescription: "Only return archived (`true`) or active (`false`) products. Omit to return both.",
+				Optional:            true,
+			},
+			"is_recurring": schema.BoolAttribute{
+				MarkdownDescription: "Only return subscription (`true`) or one-time (`false`) products. Omit to return both.",
+				Optional:            true,
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Only return products whose metadata contains all of these key-value pairs.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"organization_id": organizationIDFilterAttribute("products"),
+			"products": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching products.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: computedAttributes(resourceSchemaAttributes(ctx, NewProductResource())),
+				},
+			},
+		},
+	}
+}
+
+func (d *ProductsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
+		d.client = pd.Client
+		d.organizationID = pd.OrganizationID
+	}
+}
+
+// Read pages through the products list endpoint and maps every match.
+func (d *ProductsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = withCorrelationID(ctx)
+	var data ProductsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrganizationID = resolveOrganizationID(data.OrganizationID, d.organizationID)
+	metadata := metadataFilterValues(ctx, data.Metadata, &resp.Diagnostics)
+	limit := listPageSize
+	listReq := operations.ProductsListRequest{
+		Query:          optionalStringPointer(data.Query),
+		IsArchived:     optionalBoolPointer(data.IsArchived),
+		IsRecurring:    optionalBoolPointer(data.IsRecurring),
+		OrganizationID: organizationIDFilter(data.OrganizationID, operations.CreateProductsListQueryParamOrganizationIDFilterStr),
+		Limit:          &limit,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Products = make([]ProductResourceModel, 0)
+	page, err := d.client.Products.List(ctx, listReq)
+	for err == nil && page != nil {
+		if page.ListResourceProduct != nil {
+			for i := range page.ListResourceProduct.Items {
+				if !matchesMetadata(page.ListResourceProduct.Items[i], metadata) {
+					continue
+				}
+				data.Products = append(data.Products, productModelFromSDK(ctx, &page.ListResourceProduct.Items[i], &resp.Diagnostics))
+			}
+		}
+		page, err = page.Next()
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing products",
+			fmt.Sprintf("Could not list products: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// productModelFromSDK maps a product for data sources. The opt-in collections
+// (benefit_ids, medias, attached_custom_fields) are pre-set to empty so the
+// resource mapper always populates them, since there's no config to opt in.
+func productModelFromSDK(ctx context.Context, product *components.Product, diags *diag.Diagnostics) ProductResourceModel {
+	model := ProductResourceModel{
+		BenefitIDs:           types.SetValueMust(types.StringType, []attr.Value{}),
+		Medias:               types.ListValueMust(types.StringType, []attr.Value{}),
+		AttachedCustomFields: []AttachedCustomFieldModel{},
+	}
+	mapProductResponseToState(ctx, product, &model, diags)
+	return model
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccProductsDataSource_metadataFilter(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProductsDataSourceConfig(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.polar_products.test",
+						tfjsonpath.New("products"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.polar_products.test",
+						tfjsonpath.New("products").AtSliceIndex(0).AtMapKey("name"),
+						knownvalue.StringExact(rName+"-monthly"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.polar_products.test",
+						tfjsonpath.New("products").AtSliceIndex(0).AtMapKey("prices").AtSliceIndex(0).AtMapKey("price_amount"),
+						knownvalue.Int64Exact(1500),
+					),
+				},
+			},
+		},
+	})
+}
+
+// --- Config helpers ---
+
+// testAccProductsDataSourceConfig creates a one-time and a recurring product
+// tagged with a unique metadata value, then lists only the recurring one.
+func testAccProductsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "polar_product" "one_time" {
+  name = "%[1]s-once"
+
+  prices = [{
+    amount_type  = "fixed"
+    price_amount = 1000
+  }]
+
+  metadata = {
+    tf_acc = %[1]q
+  }
+}
+
+resource "polar_product" "monthly" {
+  name               = "%[1]s-monthly"
+  recurring_interval = "month"
+
+  prices = [{
+    amount_type  = "fixed"
+    price_amount = 1500
+  }]
+
+  metadata = {
+    tf_acc = %[1]q
+  }
+}
+
+data "polar_products" "test" {
+  is_recurring = true
+  metadata = {
+    tf_acc = %[1]q
+  }
+
+  depends_on = [polar_product.one_time, polar_product.monthly]
+}
+`, name)
+}
@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/polarsource/polar-go"
+	"github.com/polarsource/polar-go/models/components"
+	"github.com/polarsource/polar-go/models/operations"
+)
+
+// Compile-time interface conformance checks.
+var (
+	_ datasource.DataSource                   = &WebhookDeliveriesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &WebhookDeliveriesDataSource{}
+)
+
+// defaultWebhookDeliveriesMaxResults bounds how many deliveries are fetched
+// when max_results isn't set, so a busy endpoint doesn't page through its
+// whole history on every plan.
+const defaultWebhookDeliveriesMaxResults int64 = 100
+
+func NewWebhookDeliveriesDataSource() datasource.DataSource {
+	return &WebhookDeliveriesDataSource{}
+}
+
+// WebhookDeliveriesDataSource lists recent delivery attempts for a webhook
+// endpoint, for monitoring failure rates without opening the dashboard.
+type WebhookDeliveriesDataSource struct {
+	client *polargo.Polar
+}
+
+type WebhookDeliveriesDataSourceModel struct {
+	EndpointID     types.String           `tfsdk:"endpoint_id"`
+	StartTimestamp types.String           `tfsdk:"start_timestamp"`
+	EndTimestamp   types.String           `tfsdk:"end_timestamp"`
+	MaxResults     types.Int64            `tfsdk:"max_results"`
+	SucceededCount types.Int64            `tfsdk:"succeeded_count"`
+	FailedCount    types.Int64            `tfsdk:"failed_count"`
+	Deliveries     []WebhookDeliveryModel `tfsdk:"deliveries"`
+}
+
+type WebhookDeliveryModel struct {
+	ID        types.String `tfsdk:"id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Succeeded types.Bool   `tfsdk:"succeeded"`
+	HTTPCode  types.Int64  `tfsdk:"http_code"`
+	EventID   types.String `tfsdk:"event_id"`
+	EventType types.String `tfsdk:"event_type"`
+}
+
+func (d *WebhookDeliveriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_deliveries"
+}
+
+func (d *WebhookDeliveriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists recent delivery attempts for a webhook endpoint, most recent first. " +
+			"Use `failed_count` and `succeeded_count` in outputs or `check` blocks to alert on failing webhook consumers.",
+
+		Attributes: map[string]schema.Attribute{
+			"endpoint_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the webhook endpoint, e.g. `polar_webhook_endpoint.example.id`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"start_timestamp": schema.StringAttribute{
+				MarkdownDescription: "Only return deliveries created at or after this time (RFC 3339), e.g. `timeadd(plantimestamp(), \"-24h\")`.",
+				Optional:            true,
+			},
+			"end_timestamp": schema.StringAttribute{
+				MarkdownDescription: "Only return deliveries created before this time (RFC 3339).",
+				Optional:            true,
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of deliveries to return. Defaults to `%d`.", defaultWebhookDeliveriesMaxResults),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"succeeded_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of returned deliveries that succeeded.",
+				Computed:            true,
+			},
+			"failed_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of returned deliveries that failed.",
+				Computed:            true,
+			},
+			"deliveries": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching deliveries, most recent first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The delivery ID.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the delivery was attempted (RFC 3339).",
+							Computed:            true,
+						},
+						"succeeded": schema.BoolAttribute{
+							MarkdownDescription: "Whether the endpoint accepted the delivery.",
+							Computed:            true,
+						},
+						"http_code": schema.Int64Attribute{
+							MarkdownDescription: "The HTTP status code returned by the endpoint. Null if the endpoint was unreachable.",
+							Computed:            true,
+						},
+						"event_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the webhook event that was delivered. Events are retried until delivered, so one event can have several deliveries.",
+							Computed:            true,
+						},
+						"event_type": schema.StringAttribute{
+							MarkdownDescription: "The webhook event type, e.g. `order.paid`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WebhookDeliveriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
+		d.client = pd.Client
+	}
+}
+
+func (d *WebhookDeliveriesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data WebhookDeliveriesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start := validateTimestamp(data.StartTimestamp, path.Root("start_timestamp"), &resp.Diagnostics)
+	end := validateTimestamp(data.EndTimestamp, path.Root("end_timestamp"), &resp.Diagnostics)
+	if start != nil && end != nil && !end.After(*start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_timestamp"),
+			"Invalid time range",
+			fmt.Sprintf("end_timestamp (%s) must be after start_timestamp (%s).", data.EndTimestamp.ValueString(), data.StartTimestamp.ValueString()),
+		)
+	}
+}
+
+// Read pages through the endpoint's deliveries until max_results is reached.
+func (d *WebhookDeliveriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = withCorrelationID(ctx)
+	var data WebhookDeliveriesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxResults := defaultWebhookDeliveriesMaxResults
+	if !data.MaxResults.IsNull() {
+		maxResults = data.MaxResults.ValueInt64()
+	}
+	limit := min(listPageSize, maxResults)
+	endpointID := operations.CreateEndpointIDStr(data.EndpointID.ValueString())
+	listReq := operations.WebhooksListWebhookDeliveriesRequest{
+		EndpointID:     &endpointID,
+		StartTimestamp: parseOptionalTimestamp(data.StartTimestamp, &resp.Diagnostics),
+		EndTimestamp:   parseOptionalTimestamp(data.EndTimestamp, &resp.Diagnostics),
+		Limit:          &limit,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var deliveries []components.WebhookDelivery
+	page, err := d.client.Webhooks.ListWebhookDeliveries(ctx, listReq)
+	for err == nil && page != nil && int64(len(deliveries)) < maxResults {
+		if page.ListResourceWebhookDelivery == nil || len(page.ListResourceWebhookDelivery.Items) == 0 {
+			break
+		}
+		deliveries = append(deliveries, page.ListResourceWebhookDelivery.Items...)
+		page, err = page.Next()
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing webhook deliveries",
+			fmt.Sprintf("Could not list deliveries for webhook endpoint %s: %s", data.EndpointID.ValueString(), err),
+		)
+		return
+	}
+
+	mapWebhookDeliveriesToState(deliveries, maxResults, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapWebhookDeliveriesToState sorts deliveries most recent first, keeps at
+// most maxResults of them and fills in the summary counts.
+func mapWebhookDeliveriesToState(deliveries []components.WebhookDelivery, maxResults int64, data *WebhookDeliveriesDataSourceModel) {
+	slices.SortStableFunc(deliveries, func(a, b components.WebhookDelivery) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	if int64(len(deliveries)) > maxResults {
+		deliveries = deliveries[:maxResults]
+	}
+
+	var succeeded, failed int64
+	data.Deliveries = make([]WebhookDeliveryModel, 0, len(deliveries))
+	for i := range deliveries {
+		delivery := &deliveries[i]
+		if delivery.Succeeded {
+			succeeded++
+		} else {
+			failed++
+		}
+		data.Deliveries = append(data.Deliveries, WebhookDeliveryModel{
+			ID:        types.StringValue(delivery.ID),
+			CreatedAt: optionalTimestampValue(&delivery.CreatedAt),
+			Succeeded: types.BoolValue(delivery.Succeeded),
+			HTTPCode:  optionalInt64Value(delivery.HTTPCode),
+			EventID:   types.StringValue(delivery.WebhookEvent.ID),
+			EventType: types.StringValue(string(delivery.WebhookEvent.Type)),
+		})
+	}
+	data.SucceededCount = types.Int64Value(succeeded)
+	data.FailedCount = types.Int64Value(failed)
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/polarsource/polar-go/models/components"
+)
+
+func TestAccWebhookDeliveriesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A new endpoint has no deliveries yet
+			{
+				Config: testAccWebhookDeliveriesDataSourceConfig("https://example.com/webhook-deliveries"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.polar_webhook_deliveries.test",
+						tfjsonpath.New("deliveries"),
+						knownvalue.ListSizeExact(0),
+					),
+					statecheck.ExpectKnownValue(
+						"data.polar_webhook_deliveries.test",
+						tfjsonpath.New("failed_count"),
+						knownvalue.Int64Exact(0),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestMapWebhookDeliveriesToState(t *testing.T) {
+	base := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	code500 := int64(500)
+	code200 := int64(200)
+	deliveries := []components.WebhookDelivery{
+		{ID: "wd_old", CreatedAt: base, Succeeded: true, HTTPCode: &code200, WebhookEvent: components.WebhookEvent{ID: "wev_1", Type: components.WebhookEventTypeOrderPaid}},
+		{ID: "wd_new", CreatedAt: base.Add(2 * time.Minute), WebhookEvent: components.WebhookEvent{ID: "wev_2", Type: components.WebhookEventTypeOrderCreated}},
+		{ID: "wd_mid", CreatedAt: base.Add(time.Minute), HTTPCode: &code500, WebhookEvent: components.WebhookEvent{ID: "wev_2", Type: components.WebhookEventTypeOrderCreated}},
+	}
+
+	tests := []struct {
+		name          string
+		maxResults    int64
+		wantIDs       []string
+		wantSucceeded int64
+		wantFailed    int64
+	}{
+		{name: "all, most recent first", maxResults: 10, wantIDs: []string{"wd_new", "wd_mid", "wd_old"}, wantSucceeded: 1, wantFailed: 2},
+		{name: "truncated to max_results", maxResults: 2, wantIDs: []string{"wd_new", "wd_mid"}, wantSucceeded: 0, wantFailed: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data WebhookDeliveriesDataSourceModel
+			mapWebhookDeliveriesToState(append([]components.WebhookDelivery(nil), deliveries...), tt.maxResults, &data)
+
+			if len(data.Deliveries) != len(tt.wantIDs) {
+				t.Fatalf("got %d deliveries, want %d", len(data.Deliveries), len(tt.wantIDs))
+			}
+			for i, id := range tt.wantIDs {
+				if got := data.Deliveries[i].ID.ValueString(); got != id {
+					t.Errorf("deliveries[%d].id = %s, want %s", i, got, id)
+				}
+			}
+			if data.SucceededCount.ValueInt64() != tt.wantSucceeded || data.FailedCount.ValueInt64() != tt.wantFailed {
+				t.Errorf("succeeded/failed = %s/%s, want %d/%d", data.SucceededCount, data.FailedCount, tt.wantSucceeded, tt.wantFailed)
+			}
+		})
+	}
+
+	t.Run("unreachable endpoint has null http_code", func(t *testing.T) {
+		var data WebhookDeliveriesDataSourceModel
+		mapWebhookDeliveriesToState(deliveries[1:2], 10, &data)
+		got := data.Deliveries[0]
+		if !got.HTTPCode.IsNull() {
+			t.Errorf("http_code = %s, want null", got.HTTPCode)
+		}
+		if !got.EventType.Equal(types.StringValue("order.created")) || got.CreatedAt.ValueString() != "2030-01-02T03:06:05Z" {
+			t.Errorf("event_type/created_at = %s/%s", got.EventType, got.CreatedAt)
+		}
+	})
+}
+
+// --- Config helpers ---
+
+func testAccWebhookDeliveriesDataSourceConfig(url string) string {
+	return fmt.Sprintf(`
+resource "polar_webhook_endpoint" "test" {
+  url    = %q
+  format = "raw"
+  events = ["order.paid"]
+}
+
+data "polar_webhook_deliveries" "test" {
+  endpoint_id = polar_webhook_endpoint.test.id
+}
+`, url)
+}
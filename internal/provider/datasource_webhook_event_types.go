@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Compile-time interface conformance checks.
+var (
+	_ datasource.DataSource                   = &WebhookEventTypesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &WebhookEventTypesDataSource{}
+)
+
+func NewWebhookEventTypesDataSource() datasource.DataSource {
+	return &WebhookEventTypesDataSource{}
+}
+
+// WebhookEventTypesDataSource lists the webhook event types the provider
+// knows about, optionally filtered by glob patterns. It makes no API calls.
+type WebhookEventTypesDataSource struct{}
+
+type WebhookEventTypesDataSourceModel struct {
+	Patterns   types.List `tfsdk:"patterns"`
+	EventTypes types.List `tfsdk:"event_types"`
+}
+
+func (d *WebhookEventTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_event_types"
+}
+
+func (d *WebhookEventTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the webhook event types supported by `polar_webhook_endpoint`, optionally filtered by glob patterns. " +
+			"Use this to subscribe an endpoint to a whole family of events, e.g. every `subscription.*` event.",
+
+		Attributes: map[string]schema.Attribute{
+			"patterns": schema.ListAttribute{
+				MarkdownDescription: "Only return event types matching at least one of these glob patterns, e.g. `subscription.*` or `order.*`. " +
+					"`*` matches any sequence of characters and `?` matches a single character. Each pattern must match at least one event type. Omit to return all event types.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"event_types": schema.ListAttribute{
+				MarkdownDescription: "The matching event types, in a stable order.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects malformed patterns and patterns that match nothing,
+// which are almost always typos.
+func (d *WebhookEventTypesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data WebhookEventTypesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Patterns.IsNull() || data.Patterns.IsUnknown() {
+		return
+	}
+
+	for i, elem := range data.Patterns.Elements() {
+		p, ok := elem.(types.String)
+		if !ok || p.IsNull() || p.IsUnknown() {
+			continue
+		}
+		matches, err := matchWebhookEventTypes([]string{p.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("patterns").AtListIndex(i), "Invalid pattern", err.Error())
+			continue
+		}
+		if len(matches) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("patterns").AtListIndex(i),
+				"Pattern matches no event types",
+				fmt.Sprintf("Pattern %q does not match any known Polar webhook event type.", p.ValueString()),
+			)
+		}
+	}
+}
+
+func (d *WebhookEventTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = withCorrelationID(ctx)
+	var data WebhookEventTypesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patterns := []string{"*"}
+	if !data.Patterns.IsNull() {
+		patterns = nil
+		resp.Diagnostics.Append(data.Patterns.ElementsAs(ctx, &patterns, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	matches, err := matchWebhookEventTypes(patterns)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("patterns"), "Invalid pattern", err.Error())
+		return
+	}
+
+	eventTypes, diags := types.ListValueFrom(ctx, types.StringType, matches)
+	resp.Diagnostics.Append(diags...)
+	data.EventTypes = eventTypes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccWebhookEventTypesDataSource_patterns(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookEventTypesDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.polar_webhook_event_types.test",
+						tfjsonpath.New("event_types"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("order.paid"),
+							knownvalue.StringExact("refund.created"),
+							knownvalue.StringExact("refund.updated"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+// --- Config helpers ---
+
+const testAccWebhookEventTypesDataSourceConfig = `
+data "polar_webhook_event_types" "test" {
+  patterns = ["refund.*", "order.paid"]
+}
+`
@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Compile-time interface conformance checks.
+var (
+	_ ephemeral.EphemeralResource                   = &OrganizationAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &OrganizationAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &OrganizationAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose          = &OrganizationAccessTokenEphemeralResource{}
+)
+
+const (
+	defaultEphemeralTokenComment   = "Terraform ephemeral token"
+	defaultEphemeralTokenExpiresIn = time.Hour
+
+	// ephemeralTokenPrivateKey is the private data key holding the token ID
+	// between Open and Close.
+	ephemeralTokenPrivateKey = "token_id"
+)
+
+func NewOrganizationAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &OrganizationAccessTokenEphemeralResource{}
+}
+
+// OrganizationAccessTokenEphemeralResource mints a short-lived organization
+// access token on Open and revokes it on Close, so the token value never
+// lands in state or plan files. Needs the full PolarProviderData for the raw
+// HTTP calls (the SDK has no access token endpoints).
+type OrganizationAccessTokenEphemeralResource struct {
+	provider *PolarProviderData
+}
+
+type OrganizationAccessTokenEphemeralModel struct {
+	ID             types.String `tfsdk:"id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Comment        types.String `tfsdk:"comment"`
+	Scopes         types.Set    `tfsdk:"scopes"`
+	ExpiresIn      types.String `tfsdk:"expires_in"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	Token          types.String `tfsdk:"token"`
+}
+
+// ephemeralTokenPrivate is the JSON shape stored in private data for Close.
+type ephemeralTokenPrivate struct {
+	ID string `json:"id"`
+}
+
+func (e *OrganizationAccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_access_token"
+}
+
+func (e *OrganizationAccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Mints a short-lived, scoped organization access token for the duration of a Terraform run and revokes it when the run finishes. " +
+			"The token is never stored in state or plan files, so it can be passed to other providers or provisioners without persisting credentials. " +
+			"Requires Terraform 1.10 or later. The provider's own access token must be allowed to create access tokens.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The access token ID.",
+				Computed:            true,
+			},
+			"organization_id": organizationIDEphemeralAttribute("access token"),
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "A comment shown next to the token in the Polar dashboard. Defaults to `" + defaultEphemeralTokenComment + "`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "The scopes granted to the token, e.g. `products:read`. Grant only what the consumer needs.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(organizationAccessTokenScopes...)),
+				},
+			},
+			"expires_in": schema.StringAttribute{
+				MarkdownDescription: "How long the token is valid for, as a Go duration string (e.g. `30m`, `2h`). Defaults to `1h`. " +
+					"The token is revoked when the run finishes, so this only bounds its lifetime if revocation fails.",
+				Optional: true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the token expires (RFC 3339).",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The access token value.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (e *OrganizationAccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
+		e.provider = pd
+	}
+}
+
+func (e *OrganizationAccessTokenEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data OrganizationAccessTokenEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ExpiresIn.IsNull() || data.ExpiresIn.IsUnknown() {
+		return
+	}
+	if _, err := parseTokenExpiresIn(data.ExpiresIn.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_in"),
+			"Invalid expires_in",
+			err.Error(),
+		)
+	}
+}
+
+// Open mints the token and records its ID in private data so Close can revoke it.
+func (e *OrganizationAccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx = withCorrelationID(ctx)
+	var data OrganizationAccessTokenEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	comment := defaultEphemeralTokenComment
+	if !data.Comment.IsNull() {
+		comment = data.Comment.ValueString()
+	}
+	expiresIn := defaultEphemeralTokenExpiresIn
+	if !data.ExpiresIn.IsNull() {
+		d, err := parseTokenExpiresIn(data.ExpiresIn.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_in"), "Invalid expires_in", err.Error())
+			return
+		}
+		expiresIn = d
+	}
+	var scopes []string
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iso := isoDuration(expiresIn)
+	organizationID := resolveOrganizationID(data.OrganizationID, e.provider.OrganizationID)
+	result, err := createOrgAccessToken(ctx, e.provider, &orgAccessTokenCreatePayload{
+		Comment:        comment,
+		ExpiresIn:      &iso,
+		Scopes:         scopes,
+		OrganizationID: optionalStringPointer(organizationID),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating organization access token",
+			fmt.Sprintf("Could not create organization access token: %s", err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(result.OrganizationAccessToken.ID)
+	data.OrganizationID = types.StringValue(result.OrganizationAccessToken.OrganizationID)
+	data.Token = types.StringValue(result.Token)
+	data.ExpiresAt = types.StringNull()
+	if result.OrganizationAccessToken.ExpiresAt != nil {
+		data.ExpiresAt = types.StringValue(result.OrganizationAccessToken.ExpiresAt.Format(time.RFC3339))
+	}
+
+	private, err := json.Marshal(ephemeralTokenPrivate{ID: result.OrganizationAccessToken.ID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error saving organization access token ID",
+			fmt.Sprintf("Could not encode token ID for revocation: %s", err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, ephemeralTokenPrivateKey, private)...)
+
+	tflog.Trace(ctx, "created ephemeral organization access token", map[string]interface{}{"id": data.ID.ValueString()})
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close revokes the token minted by Open.
+func (e *OrganizationAccessTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	ctx = withCorrelationID(ctx)
+	raw, diags := req.Private.GetKey(ctx, ephemeralTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	var private ephemeralTokenPrivate
+	if err := json.Unmarshal(raw, &private); err != nil {
+		resp.Diagnostics.AddError(
+			"Error revoking organization access token",
+			fmt.Sprintf("Could not decode token ID: %s", err),
+		)
+		return
+	}
+
+	if err := deleteOrgAccessToken(ctx, e.provider, private.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error revoking organization access token",
+			fmt.Sprintf("Could not revoke organization access token %s: %s. It stays valid until it expires.", private.ID, err),
+		)
+		return
+	}
+	tflog.Trace(ctx, "revoked ephemeral organization access token", map[string]interface{}{"id": private.ID})
+}
+
+// parseTokenExpiresIn parses an expires_in duration string. The API works in
+// whole seconds, so anything shorter than a minute is rejected as a likely typo.
+func parseTokenExpiresIn(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("expires_in must be a duration such as \"30m\" or \"24h\", got %q", s)
+	}
+	if d < time.Minute {
+		return 0, fmt.Errorf("expires_in must be at least 1m, got %q", s)
+	}
+	return d, nil
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// Ephemeral results never reach state, so the echo provider copies them into
+// a managed resource for state checks.
+var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"polar": providerserver.NewProtocol6WithError(New("test")()),
+	"echo":  echoprovider.NewProviderServer(),
+}
+
+func TestAccOrganizationAccessTokenEphemeral_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationAccessTokenEphemeralConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("token"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^polar_oat_`)),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("expires_at"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("organization_id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestParseTokenExpiresIn(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"30m", 30 * time.Minute, false},
+		{"24h", 24 * time.Hour, false},
+		{"1h30m", 90 * time.Minute, false},
+		{"30s", 0, true},
+		{"-1h", 0, true},
+		{"1d", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseTokenExpiresIn(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseTokenExpiresIn(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseTokenExpiresIn(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsoDuration(t *testing.T) {
+	if got := isoDuration(90 * time.Minute); got != "PT5400S" {
+		t.Errorf("isoDuration(90m) = %q, want %q", got, "PT5400S")
+	}
+}
+
+// --- Config helpers ---
+
+const testAccOrganizationAccessTokenEphemeralConfig = `
+ephemeral "polar_organization_access_token" "test" {
+  comment    = "tf-acc ephemeral token"
+  scopes     = ["products:read"]
+  expires_in = "10m"
+}
+
+provider "echo" {
+  data = ephemeral.polar_organization_access_token.test
+}
+
+resource "echo" "test" {}
+`
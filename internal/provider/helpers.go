@@ -6,8 +6,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -36,16 +38,18 @@ func latestTimestamp(t Timestamped) time.Time {
 	return t.GetCreatedAt()
 }
 
-// Polling constants for eventual consistency handling.
-const (
-	pollMaxAttempts = 10
-	pollInterval    = 500 * time.Millisecond
-)
-
-// isNotFound checks if an error is a Polar API 404 ResourceNotFound error.
+// isNotFound checks if an error is a Polar API 404, from the SDK or the
+// supplemental client. Supplemental 404s must carry Polar's ResourceNotFound
+// error name, so a 404 from a proxy or misrouted base_url doesn't count.
 func isNotFound(err error) bool {
 	var notFound *apierrors.ResourceNotFound
-	return errors.As(err, &notFound)
+	if errors.As(err, &notFound) {
+		return true
+	}
+	var supplementalErr *supplementalAPIError
+	return errors.As(err, &supplementalErr) &&
+		supplementalErr.StatusCode == http.StatusNotFound &&
+		supplementalErr.Type == "ResourceNotFound"
 }
 
 // isTransient checks if an error is a transient API error (429 rate limit or
@@ -56,6 +60,10 @@ func isTransient(err error) bool {
 	if errors.As(err, &apiErr) {
 		return apiErr.StatusCode == 429 || apiErr.StatusCode >= 500
 	}
+	var supplementalErr *supplementalAPIError
+	if errors.As(err, &supplementalErr) {
+		return supplementalErr.StatusCode == 429 || supplementalErr.StatusCode >= 500
+	}
 	return false
 }
 
@@ -80,10 +88,10 @@ func extractProviderData(providerData any, diags *diag.Diagnostics) *PolarProvid
 // logs it and removes the resource from Terraform state. Returns true if the error
 // was a 404 (caller should return early), false otherwise.
 //
-// Safe against transient infrastructure failures: isNotFound only matches the SDK's
-// typed ResourceNotFound (a structured JSON response from Polar's API). Generic
-// 404s from CDN/DNS/load balancers return a different error type and won't match,
-// so outages won't cause resources to be dropped from state.
+// Safe against transient infrastructure failures: isNotFound only matches a
+// ResourceNotFound error body from Polar's API. Generic 404s from CDN/DNS/load
+// balancers or proxies don't carry one and won't match, so outages won't cause
+// resources to be dropped from state.
 func handleNotFoundRemove(ctx context.Context, err error, resourceType, id string, state *tfsdk.State) bool {
 	if !isNotFound(err) {
 		return false
@@ -111,6 +119,15 @@ func optionalInt64Value(i *int64) types.Int64 {
 	return types.Int64Value(*i)
 }
 
+// optionalBoolValue safely converts a *bool to types.Bool,
+// returning types.BoolNull() if the pointer is nil.
+func optionalBoolValue(b *bool) types.Bool {
+	if b == nil {
+		return types.BoolNull()
+	}
+	return types.BoolValue(*b)
+}
+
 // derefBool safely dereferences a *bool, returning false if nil.
 func derefBool(b *bool) bool {
 	if b == nil {
@@ -119,24 +136,102 @@ func derefBool(b *bool) bool {
 	return *b
 }
 
+// --- Terraform type → nil-safe pointer converters ---
+
+// optionalStringPointer converts a types.String to *string, returning nil for null/unknown.
+func optionalStringPointer(s types.String) *string {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+	v := s.ValueString()
+	return &v
+}
+
+// optionalInt64Pointer converts a types.Int64 to *int64, returning nil for null/unknown.
+func optionalInt64Pointer(i types.Int64) *int64 {
+	if i.IsNull() || i.IsUnknown() {
+		return nil
+	}
+	v := i.ValueInt64()
+	return &v
+}
+
+// optionalBoolPointer converts a types.Bool to *bool, returning nil for null/unknown.
+func optionalBoolPointer(b types.Bool) *bool {
+	if b.IsNull() || b.IsUnknown() {
+		return nil
+	}
+	v := b.ValueBool()
+	return &v
+}
+
+// --- Clearing removed fields ---
+// polar-go tags every update field omitempty, so an SDK update can't unset a
+// field or send an empty list or map. Update handlers collect those fields
+// with clearRemovedField and PATCH them through the supplemental client.
+
+// clearRemovedField sets payload[field] to clear when the attribute is null or
+// empty in the plan but was set in prior state.
+func clearRemovedField(payload map[string]any, field string, planned, prior attr.Value, clear any) {
+	if isEmptyValue(planned) && !isEmptyValue(prior) {
+		payload[field] = clear
+	}
+}
+
+// patchClearedFields sends payload, the fields an SDK update can't clear (see
+// clearRemovedField), as a raw PATCH to path, and does nothing when it is
+// empty. Update handlers call it before their SDK update, so the SDK update
+// stays the latest write: its modified_at is the timestamp pollForConsistency
+// waits for.
+func patchClearedFields(ctx context.Context, c *supplementalClient, path string, payload map[string]any) error {
+	if len(payload) == 0 {
+		return nil
+	}
+	return c.do(ctx, http.MethodPatch, path, payload, nil)
+}
+
+// isEmptyValue reports whether v is null or a known list, set or map without
+// elements. Unknown values are never empty.
+func isEmptyValue(v attr.Value) bool {
+	if v.IsNull() {
+		return true
+	}
+	if v.IsUnknown() {
+		return false
+	}
+	switch v := v.(type) {
+	case types.List:
+		return len(v.Elements()) == 0
+	case types.Set:
+		return len(v.Elements()) == 0
+	case types.Map:
+		return len(v.Elements()) == 0
+	}
+	return false
+}
+
 // pollForConsistency polls fetch until it returns a result whose timestamp is
 // at or after writeTimestamp. Retries on ResourceNotFound, transient errors
-// (429/5xx), and stale reads. If polling exhausts all attempts and at least
-// one successful read was obtained, the last result is returned with a warning
-// diagnostic. If no successful read was ever obtained (e.g. persistent 404),
+// (429/5xx), and stale reads, up to policy.MaxAttempts reads spaced by
+// policy.Interval. If polling exhausts all attempts and at least one successful
+// read was obtained, the last result is returned with a warning diagnostic, or
+// an error diagnostic when policy.Strict is set. Callers save the stale result
+// either way, so a strict failure on create leaves the resource tainted rather
+// than orphaned. If no successful read was ever obtained (e.g. persistent 404),
 // a hard error is returned.
-func pollForConsistency[T Timestamped](ctx context.Context, resourceType, id string, writeTimestamp time.Time, fetch func() (T, error), diags *diag.Diagnostics) (T, error) {
+func pollForConsistency[T Timestamped](ctx context.Context, policy consistencyPolicy, resourceType, id string, writeTimestamp time.Time, fetch func() (T, error), diags *diag.Diagnostics) (T, error) {
 	var last T
 	var hasResult bool
 	var lastRejectReason string
+	clk := clockOrSystem(policy.Clock)
 
-	for i := 0; i < pollMaxAttempts; i++ {
+	for i := 0; i < policy.MaxAttempts; i++ {
 		if i > 0 {
 			select {
 			case <-ctx.Done():
 				var zero T
 				return zero, ctx.Err()
-			case <-time.After(pollInterval):
+			case <-clk.After(policy.Interval):
 			}
 		}
 		result, err := fetch()
@@ -169,20 +264,33 @@ func pollForConsistency[T Timestamped](ctx context.Context, resourceType, id str
 	// If we never got a successful read, return a hard error.
 	if !hasResult {
 		var zero T
-		msg := fmt.Sprintf("%s %s not readable after %d polls", resourceType, id, pollMaxAttempts)
+		msg := fmt.Sprintf("%s %s not readable after %d polls", resourceType, id, policy.MaxAttempts)
 		if lastRejectReason != "" {
 			msg += ": " + lastRejectReason
 		}
 		return zero, fmt.Errorf("%s", msg)
 	}
 
-	// We got at least one read but it was stale — return it with a warning.
+	// We got at least one read but it was stale — return it with a warning,
+	// or with an error in strict mode.
+	if policy.Strict {
+		diags.AddError(
+			"Eventual consistency timeout",
+			fmt.Sprintf(
+				"%s %s read-back did not converge after %d polls (%s). "+
+					"Strict consistency is enabled, so the stale result was saved and the operation failed. "+
+					"Run terraform apply again once the API has caught up, or raise consistency.max_attempts.",
+				resourceType, id, policy.MaxAttempts, lastRejectReason,
+			),
+		)
+		return last, nil
+	}
 	diags.AddWarning(
 		"Eventual consistency timeout",
 		fmt.Sprintf(
 			"%s %s read-back did not converge after %d polls (%s). "+
 				"The state may not reflect the latest changes. Run terraform refresh to re-sync.",
-			resourceType, id, pollMaxAttempts, lastRejectReason,
+			resourceType, id, policy.MaxAttempts, lastRejectReason,
 		),
 	)
 	return last, nil
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/polarsource/polar-go/models/apierrors"
+)
+
+// pollRead is one scripted fetch result for pollForConsistency.
+type pollRead struct {
+	modified time.Time
+	err      error
+}
+
+func (r pollRead) GetCreatedAt() time.Time   { return r.modified.Add(-time.Hour) }
+func (r pollRead) GetModifiedAt() *time.Time { return &r.modified }
+
+func TestPollForConsistency_faults(t *testing.T) {
+	write := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	stale := pollRead{modified: write.Add(-time.Second)}
+	fresh := pollRead{modified: write}
+	notFound := pollRead{err: &apierrors.ResourceNotFound{Error_: "ResourceNotFound", Detail: "Not found"}}
+	rateLimited := pollRead{err: apierrors.NewAPIError("API error occurred", 429, "", nil)}
+	unavailable := pollRead{err: apierrors.NewAPIError("API error occurred", 503, "", nil)}
+	badRequest := pollRead{err: apierrors.NewAPIError("API error occurred", 400, "", nil)}
+
+	tests := []struct {
+		name   string
+		reads  []pollRead // the last read repeats
+		cancel bool       // cancel the context during the first wait
+		// want is the read returned, or nil when an error is expected.
+		want         *pollRead
+		wantErr      string
+		wantFetches  int
+		wantWaits    int
+		wantWarnings int
+	}{
+		{name: "fresh at once", reads: []pollRead{fresh}, want: &fresh, wantFetches: 1},
+		{name: "stale then fresh", reads: []pollRead{stale, stale, fresh}, want: &fresh, wantFetches: 3, wantWaits: 2},
+		{name: "not found then fresh", reads: []pollRead{notFound, fresh}, want: &fresh, wantFetches: 2, wantWaits: 1},
+		{name: "transient errors then fresh", reads: []pollRead{rateLimited, unavailable, fresh}, want: &fresh, wantFetches: 3, wantWaits: 2},
+		{name: "persistent 404", reads: []pollRead{notFound}, wantErr: "not readable after 4 polls: resource not found", wantFetches: 4, wantWaits: 3},
+		{name: "persistent 503", reads: []pollRead{unavailable}, wantErr: "not readable after 4 polls: transient error", wantFetches: 4, wantWaits: 3},
+		{name: "persistent stale", reads: []pollRead{stale}, want: &stale, wantFetches: 4, wantWaits: 3, wantWarnings: 1},
+		{name: "stale then 404", reads: []pollRead{stale, notFound}, want: &stale, wantFetches: 4, wantWaits: 3, wantWarnings: 1},
+		{name: "non-retryable error", reads: []pollRead{stale, badRequest}, wantErr: "Status 400", wantFetches: 2, wantWaits: 1},
+		{name: "context cancelled", reads: []pollRead{stale}, cancel: true, wantErr: context.Canceled.Error(), wantFetches: 1, wantWaits: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			clk := &fakeClock{now: write}
+			if tt.cancel {
+				clk.cancel = cancel
+			}
+			policy := consistencyPolicy{MaxAttempts: 4, Interval: time.Second, Clock: clk}
+
+			var fetches int
+			var diags diag.Diagnostics
+			got, err := pollForConsistency(ctx, policy, "meter", "m_1", write, func() (pollRead, error) {
+				read := tt.reads[min(fetches, len(tt.reads)-1)]
+				fetches++
+				if read.err != nil {
+					return pollRead{}, read.err
+				}
+				return read, nil
+			}, &diags)
+
+			if tt.want != nil {
+				if err != nil {
+					t.Fatalf("err = %v, want %+v", err, *tt.want)
+				}
+				if got != *tt.want {
+					t.Errorf("result = %+v, want %+v", got, *tt.want)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want one containing %q", err, tt.wantErr)
+			}
+			if tt.cancel && !errors.Is(err, context.Canceled) {
+				t.Errorf("err = %v, want context.Canceled", err)
+			}
+			if fetches != tt.wantFetches {
+				t.Errorf("fetches = %d, want %d", fetches, tt.wantFetches)
+			}
+			if len(clk.waits) != tt.wantWaits {
+				t.Errorf("waits = %v, want %d", clk.waits, tt.wantWaits)
+			}
+			for i, wait := range clk.waits {
+				if wait != policy.Interval {
+					t.Errorf("wait %d = %s, want %s", i, wait, policy.Interval)
+				}
+			}
+			if diags.HasError() || diags.WarningsCount() != tt.wantWarnings {
+				t.Errorf("diagnostics = %v, want %d warnings", diags, tt.wantWarnings)
+			}
+		})
+	}
+}
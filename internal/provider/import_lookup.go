@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// --- Import by lookup ---
+// Products, meters and benefits can be imported by ID or by a lookup, so
+// import blocks don't need UUIDs copied from the dashboard:
+//
+//	name:Pro Plan        exact name (description for benefits)
+//	metadata:tf_key=pro  metadata key and value
+//
+// The lookup goes through the same list-and-match code as the data sources,
+// so zero or several matches fail with the same errors.
+
+const (
+	importNamePrefix     = "name:"
+	importMetadataPrefix = "metadata:"
+)
+
+// objectLookup finds an object by exact name, by one metadata key and value,
+// or both.
+type objectLookup struct {
+	Name          string
+	MetadataKey   string
+	MetadataValue string
+}
+
+// criteria describes the lookup for error messages, e.g. `name "Pro"` or
+// `metadata "tf_key" = "pro"`. nameField names what Name matches.
+func (l objectLookup) criteria(nameField string) string {
+	var parts []string
+	if l.Name != "" {
+		parts = append(parts, fmt.Sprintf("%s %q", nameField, l.Name))
+	}
+	if l.MetadataKey != "" {
+		parts = append(parts, fmt.Sprintf("metadata %q = %q", l.MetadataKey, l.MetadataValue))
+	}
+	return strings.Join(parts, " and ")
+}
+
+// query returns the list endpoints' search query, or nil without a name.
+func (l objectLookup) query() *string {
+	if l.Name == "" {
+		return nil
+	}
+	return &l.Name
+}
+
+// metadataFilter returns the lookup's metadata as a filter for
+// matchesMetadata, or nil without a metadata key.
+func (l objectLookup) metadataFilter() map[string]string {
+	if l.MetadataKey == "" {
+		return nil
+	}
+	return map[string]string{l.MetadataKey: l.MetadataValue}
+}
+
+// matches reports whether an object with the given name and SDK value obj
+// matches the lookup.
+func (l objectLookup) matches(name string, obj any) bool {
+	if l.Name != "" && name != l.Name {
+		return false
+	}
+	return matchesMetadata(obj, l.metadataFilter())
+}
+
+// parseImportLookup parses a "name:" or "metadata:" import ID. It returns
+// nil for any other ID, which is imported as-is.
+func parseImportLookup(id string) (*objectLookup, error) {
+	switch {
+	case strings.HasPrefix(id, importNamePrefix):
+		name := strings.TrimPrefix(id, importNamePrefix)
+		if name == "" {
+			return nil, fmt.Errorf("%q has no name after %q", id, importNamePrefix)
+		}
+		return &objectLookup{Name: name}, nil
+	case strings.HasPrefix(id, importMetadataPrefix):
+		key, value, ok := strings.Cut(strings.TrimPrefix(id, importMetadataPrefix), "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%q is not of the form %q", id, importMetadataPrefix+"<key>=<value>")
+		}
+		return &objectLookup{MetadataKey: key, MetadataValue: value}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// importStateByLookup imports by ID, or resolves a "name:" or "metadata:"
+// import ID to the ID of the single object find returns. find reports its
+// own errors and returns "" when there is no single match.
+func importStateByLookup(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, find func(objectLookup, *diag.Diagnostics) string) {
+	lookup, err := parseImportLookup(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an ID, %q or %q: %s", importNamePrefix+"<name>", importMetadataPrefix+"<key>=<value>", err),
+		)
+		return
+	}
+	if lookup == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	id := find(*lookup, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/sjkchang/terraform-provider-polar/internal/polartest"
+)
+
+func TestParseImportLookup(t *testing.T) {
+	tests := []struct {
+		id      string
+		want    *objectLookup
+		wantErr bool
+	}{
+		{id: "9b4f6a2e-3c1d-4e5f-8a7b-0c1d2e3f4a5b"},
+		{id: "name:Pro Plan", want: &objectLookup{Name: "Pro Plan"}},
+		{id: "name:a:b=c", want: &objectLookup{Name: "a:b=c"}},
+		{id: "metadata:tf_key=pro", want: &objectLookup{MetadataKey: "tf_key", MetadataValue: "pro"}},
+		{id: "metadata:tf_key=a=b", want: &objectLookup{MetadataKey: "tf_key", MetadataValue: "a=b"}},
+		{id: "metadata:tf_key=", want: &objectLookup{MetadataKey: "tf_key"}},
+		{id: "name:", wantErr: true},
+		{id: "metadata:tf_key", wantErr: true},
+		{id: "metadata:=pro", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got, err := parseImportLookup(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("lookup = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImportStateByLookup(t *testing.T) {
+	ctx := context.Background()
+	server := polartest.NewServer()
+	defer server.Close()
+
+	t.Setenv("POLAR_SERVER", "")
+	t.Setenv("POLAR_ORGANIZATION_ID", "")
+	pd, diags := configureTestProvider(t, map[string]any{
+		"access_token": "polar_oat_test",
+		"base_url":     server.URL(),
+	})
+	if diags.HasError() {
+		t.Fatalf("Configure: %v", diags)
+	}
+
+	// "API" and "API calls" check that names match exactly, not as the API's
+	// substring query; the two "Storage" meters make that name ambiguous.
+	meterIDs := map[string]string{}
+	for _, m := range []struct{ name, key string }{
+		{"API", "api"}, {"API calls", "calls"}, {"Storage", "storage"}, {"Storage", "storage-eu"},
+	} {
+		meter, err := supplementalPost[map[string]any](ctx, pd.Supplemental, "/v1/meters/", map[string]any{
+			"name":        m.name,
+			"filter":      map[string]any{"conjunction": "and", "clauses": []any{}},
+			"aggregation": map[string]any{"func": "count"},
+			"metadata":    map[string]any{"tf_key": m.key},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		meterIDs[m.key] = (*meter)["id"].(string)
+	}
+	benefit, err := supplementalPost[map[string]any](ctx, pd.Supplemental, "/v1/benefits/", map[string]any{
+		"type":        "custom",
+		"description": "Priority support",
+		"properties":  map[string]any{},
+		"metadata":    map[string]any{"tier": 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		resource resource.Resource
+		id       string
+		want     string // the imported ID; empty when an error is expected
+		wantErr  string
+	}{
+		{name: "passthrough", resource: NewMeterResource(), id: "m_unknown", want: "m_unknown"},
+		{name: "meter by name", resource: NewMeterResource(), id: "name:API", want: meterIDs["api"]},
+		{name: "meter by metadata", resource: NewMeterResource(), id: "metadata:tf_key=storage-eu", want: meterIDs["storage-eu"]},
+		{name: "ambiguous name", resource: NewMeterResource(), id: "name:Storage", wantErr: `Found 2 meters with name "Storage"`},
+		{name: "no match", resource: NewMeterResource(), id: "metadata:tf_key=missing", wantErr: `No meter found with metadata "tf_key" = "missing"`},
+		{name: "invalid", resource: NewMeterResource(), id: "metadata:tf_key", wantErr: "Invalid import ID"},
+		{name: "product by name", resource: NewProductResource(), id: "name:API", wantErr: `No product found with name "API"`},
+		{name: "benefit by description", resource: NewBenefitResource(), id: "name:Priority support", want: (*benefit)["id"].(string)},
+		{name: "benefit by integer metadata", resource: NewBenefitResource(), id: "metadata:tier=3", want: (*benefit)["id"].(string)},
+		{name: "benefit by name", resource: NewBenefitResource(), id: "name:Priority", wantErr: `No benefit found with description "Priority"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tt.resource
+			var configureResp resource.ConfigureResponse
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: pd}, &configureResp)
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			resp := resource.ImportStateResponse{State: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}}
+			r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, &resp)
+
+			if tt.wantErr != "" {
+				if !resp.Diagnostics.HasError() {
+					t.Fatalf("imported without error, want one containing %q", tt.wantErr)
+				}
+				err := resp.Diagnostics.Errors()[0]
+				if msg := err.Summary() + ": " + err.Detail(); !strings.Contains(msg, tt.wantErr) {
+					t.Errorf("error = %q, want one containing %q", msg, tt.wantErr)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ImportState: %v", resp.Diagnostics)
+			}
+			var id string
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			if id != tt.want {
+				t.Errorf("imported id = %q, want %q", id, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/polarsource/polar-go/models/components"
+)
+
+// The SDK has no organization access token endpoints, so tokens are managed
+// through the supplemental client against /v1/organization-access-tokens.
+
+// organizationAccessTokenScopes are the scopes an organization access token
+// can be granted. OpenID scopes (openid, profile, email) and dashboard-only
+// scopes (web:*) are excluded since they don't apply to API tokens.
+var organizationAccessTokenScopes = scopeStrings(
+	components.ScopeUserRead,
+	components.ScopeOrganizationsRead, components.ScopeOrganizationsWrite,
+	components.ScopeCustomFieldsRead, components.ScopeCustomFieldsWrite,
+	components.ScopeDiscountsRead, components.ScopeDiscountsWrite,
+	components.ScopeCheckoutLinksRead, components.ScopeCheckoutLinksWrite,
+	components.ScopeCheckoutsRead, components.ScopeCheckoutsWrite,
+	components.ScopeTransactionsRead, components.ScopeTransactionsWrite,
+	components.ScopePayoutsRead, components.ScopePayoutsWrite,
+	components.ScopeProductsRead, components.ScopeProductsWrite,
+	components.ScopeBenefitsRead, components.ScopeBenefitsWrite,
+	components.ScopeEventsRead, components.ScopeEventsWrite,
+	components.ScopeMetersRead, components.ScopeMetersWrite,
+	components.ScopeFilesRead, components.ScopeFilesWrite,
+	components.ScopeSubscriptionsRead, components.ScopeSubscriptionsWrite,
+	components.ScopeCustomersRead, components.ScopeCustomersWrite,
+	components.ScopeWalletsRead, components.ScopeWalletsWrite,
+	components.ScopeCustomerMetersRead,
+	components.ScopeCustomerSessionsWrite,
+	components.ScopeCustomerSeatsRead, components.ScopeCustomerSeatsWrite,
+	components.ScopeOrdersRead, components.ScopeOrdersWrite,
+	components.ScopeRefundsRead, components.ScopeRefundsWrite,
+	components.ScopePaymentsRead,
+	components.ScopeMetricsRead,
+	components.ScopeWebhooksRead, components.ScopeWebhooksWrite,
+	components.ScopeExternalOrganizationsRead,
+	components.ScopeLicenseKeysRead, components.ScopeLicenseKeysWrite,
+	components.ScopeRepositoriesRead, components.ScopeRepositoriesWrite,
+	components.ScopeIssuesRead, components.ScopeIssuesWrite,
+	components.ScopeCustomerPortalRead, components.ScopeCustomerPortalWrite,
+	components.ScopeNotificationsRead, components.ScopeNotificationsWrite,
+	components.ScopeNotificationRecipientsRead, components.ScopeNotificationRecipientsWrite,
+)
+
+func scopeStrings(scopes ...components.Scope) []string {
+	result := make([]string, len(scopes))
+	for i, s := range scopes {
+		result[i] = string(s)
+	}
+	return result
+}
+
+// --- Raw HTTP payloads ---
+
+type orgAccessTokenCreatePayload struct {
+	Comment        string   `json:"comment"`
+	ExpiresIn      *string  `json:"expires_in"` // ISO 8601 duration; null never expires
+	Scopes         []string `json:"scopes"`
+	OrganizationID *string  `json:"organization_id,omitempty"` // inferred from an organization token when omitted
+}
+
+type orgAccessToken struct {
+	ID             string     `json:"id"`
+	OrganizationID string     `json:"organization_id"`
+	Comment        string     `json:"comment"`
+	Scopes         []string   `json:"scopes"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+}
+
+type orgAccessTokenCreateResponse struct {
+	OrganizationAccessToken orgAccessToken `json:"organization_access_token"`
+	Token                   string         `json:"token"`
+}
+
+type orgAccessTokenUpdatePayload struct {
+	Comment *string  `json:"comment,omitempty"`
+	Scopes  []string `json:"scopes,omitempty"`
+}
+
+type orgAccessTokenListResponse struct {
+	Items      []orgAccessToken `json:"items"`
+	Pagination struct {
+		MaxPage int `json:"max_page"`
+	} `json:"pagination"`
+}
+
+// isoDuration formats d as an ISO 8601 duration in whole seconds (e.g. "PT3600S"),
+// the format the API expects for expires_in.
+func isoDuration(d time.Duration) string {
+	return fmt.Sprintf("PT%dS", int64(d/time.Second))
+}
+
+// --- Raw HTTP calls ---
+
+// createOrgAccessToken mints a new organization access token. The token value
+// is only returned by this call.
+func createOrgAccessToken(ctx context.Context, pd *PolarProviderData, payload *orgAccessTokenCreatePayload) (*orgAccessTokenCreateResponse, error) {
+	return supplementalPost[orgAccessTokenCreateResponse](ctx, pd.Supplemental, "/v1/organization-access-tokens/", payload)
+}
+
+// getOrgAccessToken finds a token by ID. The API has no single-token GET, so
+// this pages through the list endpoint. Returns nil (no error) if the token
+// doesn't exist.
+func getOrgAccessToken(ctx context.Context, pd *PolarProviderData, id string) (*orgAccessToken, error) {
+	for page := 1; ; page++ {
+		path := fmt.Sprintf("/v1/organization-access-tokens/?page=%d&limit=%d", page, listPageSize)
+		result, err := supplementalGet[orgAccessTokenListResponse](ctx, pd.Supplemental, path)
+		if err != nil {
+			return nil, err
+		}
+		for i := range result.Items {
+			if result.Items[i].ID == id {
+				return &result.Items[i], nil
+			}
+		}
+		if page >= result.Pagination.MaxPage {
+			return nil, nil
+		}
+	}
+}
+
+// updateOrgAccessToken changes a token's comment and scopes.
+func updateOrgAccessToken(ctx context.Context, pd *PolarProviderData, id string, payload *orgAccessTokenUpdatePayload) (*orgAccessToken, error) {
+	return supplementalPatch[orgAccessToken](ctx, pd.Supplemental, "/v1/organization-access-tokens/"+url.PathEscape(id), payload)
+}
+
+// deleteOrgAccessToken revokes a token. A token that no longer exists is
+// treated as already revoked.
+func deleteOrgAccessToken(ctx context.Context, pd *PolarProviderData, id string) error {
+	err := pd.Supplemental.do(ctx, http.MethodDelete, "/v1/organization-access-tokens/"+url.PathEscape(id), nil, nil)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	eschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// --- Organization scoping ---
+// Organization access tokens are scoped to one organization, so the API
+// infers the organization on create and list calls. Personal (user) tokens
+// can reach several organizations and need organization_id set explicitly.
+// Resources take an optional organization_id that falls back to the
+// provider-level organization_id, and then to the API's inference.
+
+// organizationIDAttribute returns the organization_id attribute shared by
+// resources that belong to an organization. Moving a resource to another
+// organization isn't supported by the API, so changing it forces replacement.
+func organizationIDAttribute(kind string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("The ID of the organization that owns the %s. Defaults to the provider's `organization_id`, "+
+			"or to the access token's organization. Required when using a personal access token that can access several organizations. "+
+			"Changing this forces a new resource.", kind),
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// organizationIDFilterAttribute returns the optional organization_id
+// attribute for data sources that list or look up resources.
+func organizationIDFilterAttribute(kind string, validators ...validator.String) dschema.StringAttribute {
+	return dschema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Only look in this organization's %s. Defaults to the provider's `organization_id`, "+
+			"or to the access token's organization.", kind),
+		Optional:   true,
+		Computed:   true,
+		Validators: validators,
+	}
+}
+
+// organizationIDEphemeralAttribute returns the optional organization_id
+// attribute for ephemeral resources, which are opened anew on every run and
+// so have nothing to replace.
+func organizationIDEphemeralAttribute(kind string) eschema.StringAttribute {
+	return eschema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("The ID of the organization that owns the %s. Defaults to the provider's `organization_id`, "+
+			"or to the access token's organization. Required when using a personal access token that can access several organizations.", kind),
+		Optional: true,
+		Computed: true,
+	}
+}
+
+// resolveOrganizationID returns the organization ID to send on create and
+// list calls: the configured value, else the provider default. The result is
+// unknown or null when neither is set, so optionalStringPointer leaves it out
+// and the API infers the organization from the access token.
+func resolveOrganizationID(configured types.String, providerDefault string) types.String {
+	if configured.IsNull() || configured.IsUnknown() {
+		if providerDefault != "" {
+			return types.StringValue(providerDefault)
+		}
+	}
+	return configured
+}
+
+// organizationIDFilter wraps a resolved organization ID in the SDK's
+// per-endpoint list filter union, or returns nil to leave the filter unset.
+func organizationIDFilter[T any](organizationID types.String, create func(string) T) *T {
+	if organizationID.IsNull() || organizationID.IsUnknown() {
+		return nil
+	}
+	filter := create(organizationID.ValueString())
+	return &filter
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/polarsource/polar-go/models/operations"
+)
+
+func TestResolveOrganizationID(t *testing.T) {
+	tests := []struct {
+		name            string
+		configured      types.String
+		providerDefault string
+		want            types.String
+	}{
+		{name: "configured wins", configured: types.StringValue("org_a"), providerDefault: "org_b", want: types.StringValue("org_a")},
+		{name: "null falls back to provider", configured: types.StringNull(), providerDefault: "org_b", want: types.StringValue("org_b")},
+		{name: "unknown falls back to provider", configured: types.StringUnknown(), providerDefault: "org_b", want: types.StringValue("org_b")},
+		{name: "neither set stays null", configured: types.StringNull(), want: types.StringNull()},
+		{name: "neither set stays unknown", configured: types.StringUnknown(), want: types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolveOrganizationID(tt.configured, tt.providerDefault)
+			if !got.Equal(tt.want) {
+				t.Errorf("resolveOrganizationID() = %s, want %s", got, tt.want)
+			}
+			if ptr := optionalStringPointer(got); (ptr == nil) != (tt.want.IsNull() || tt.want.IsUnknown()) {
+				t.Errorf("optionalStringPointer() = %v, want nil only when unresolved", ptr)
+			}
+		})
+	}
+}
+
+func TestOrganizationIDFilter(t *testing.T) {
+	if got := organizationIDFilter(types.StringNull(), operations.CreateMetersListQueryParamOrganizationIDFilterStr); got != nil {
+		t.Errorf("null ID: got %+v, want nil", got)
+	}
+	if got := organizationIDFilter(types.StringUnknown(), operations.CreateMetersListQueryParamOrganizationIDFilterStr); got != nil {
+		t.Errorf("unknown ID: got %+v, want nil", got)
+	}
+
+	got := organizationIDFilter(types.StringValue("org_a"), operations.CreateMetersListQueryParamOrganizationIDFilterStr)
+	if got == nil || got.Str == nil || *got.Str != "org_a" {
+		t.Errorf("set ID: got %+v, want filter on org_a", got)
+	}
+}
+
+func TestPolarProviderData_claimOrganization(t *testing.T) {
+	pd := &PolarProviderData{}
+
+	if err := pd.ClaimOrganization("org_a"); err != nil {
+		t.Fatalf("first claim of org_a: %v", err)
+	}
+	if err := pd.ClaimOrganization("org_b"); err != nil {
+		t.Fatalf("claim of a second organization: %v", err)
+	}
+	if err := pd.ClaimOrganization("org_a"); err == nil {
+		t.Fatal("second claim of org_a: want error, got nil")
+	}
+
+	pd.ReleaseOrganization("org_a")
+	if err := pd.ClaimOrganization("org_a"); err != nil {
+		t.Fatalf("claim of org_a after release: %v", err)
+	}
+}
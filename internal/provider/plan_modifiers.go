@@ -5,8 +5,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // requiresReplaceWithArchiveWarning returns a plan modifier that behaves like
@@ -61,3 +65,47 @@ func (m *archiveReplaceModifier) PlanModifyString(ctx context.Context, req planm
 		),
 	)
 }
+
+// unknownWhenChanged returns a plan modifier that marks a computed string as
+// unknown when any of the given sibling attributes changes on update. Use it
+// after UseStateForUnknown for server-generated values that a trigger
+// attribute regenerates (e.g. a webhook secret reset).
+func unknownWhenChanged(triggers ...path.Path) planmodifier.String {
+	return &unknownWhenChangedModifier{triggers: triggers}
+}
+
+type unknownWhenChangedModifier struct {
+	triggers []path.Path
+}
+
+func (m *unknownWhenChangedModifier) Description(_ context.Context) string {
+	names := make([]string, len(m.triggers))
+	for i, p := range m.triggers {
+		names[i] = p.String()
+	}
+	return fmt.Sprintf("The value is regenerated when %s changes.", strings.Join(names, " or "))
+}
+
+func (m *unknownWhenChangedModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m *unknownWhenChangedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing on resource creation or destruction.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	for _, p := range m.triggers {
+		var planValue, stateValue attr.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, p, &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, p, &stateValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !planValue.Equal(stateValue) {
+			resp.PlanValue = types.StringUnknown()
+			return
+		}
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -101,3 +103,69 @@ func TestArchiveReplaceModifier_resourceDestruction(t *testing.T) {
 		t.Errorf("expected no warnings on resource destruction, got %d", resp.Diagnostics.WarningsCount())
 	}
 }
+
+// unknownWhenChangedData builds plan/state data with a secret and a trigger attribute.
+func unknownWhenChangedData(t *testing.T, trigger tftypes.Value) (tfsdk.Plan, tfsdk.State) {
+	t.Helper()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"secret":  schema.StringAttribute{Computed: true},
+			"trigger": schema.StringAttribute{Optional: true},
+		},
+	}
+	objType := s.Type().TerraformType(context.Background())
+	state := tftypes.NewValue(objType, map[string]tftypes.Value{
+		"secret":  tftypes.NewValue(tftypes.String, "old-secret"),
+		"trigger": tftypes.NewValue(tftypes.String, "1"),
+	})
+	plan := tftypes.NewValue(objType, map[string]tftypes.Value{
+		"secret":  tftypes.NewValue(tftypes.String, "old-secret"),
+		"trigger": trigger,
+	})
+	return tfsdk.Plan{Schema: s, Raw: plan}, tfsdk.State{Schema: s, Raw: state}
+}
+
+func TestUnknownWhenChangedModifier(t *testing.T) {
+	tests := []struct {
+		name        string
+		trigger     tftypes.Value
+		wantUnknown bool
+	}{
+		{"trigger changed", tftypes.NewValue(tftypes.String, "2"), true},
+		{"trigger removed", tftypes.NewValue(tftypes.String, nil), true},
+		{"trigger unchanged", tftypes.NewValue(tftypes.String, "1"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, state := unknownWhenChangedData(t, tt.trigger)
+			resp := &planmodifier.StringResponse{PlanValue: types.StringValue("old-secret")}
+			unknownWhenChanged(path.Root("trigger")).PlanModifyString(context.Background(), planmodifier.StringRequest{
+				StateValue: types.StringValue("old-secret"),
+				PlanValue:  types.StringValue("old-secret"),
+				State:      state,
+				Plan:       plan,
+			}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if resp.PlanValue.IsUnknown() != tt.wantUnknown {
+				t.Errorf("PlanValue = %s, want unknown = %t", resp.PlanValue, tt.wantUnknown)
+			}
+		})
+	}
+}
+
+func TestUnknownWhenChangedModifier_resourceCreation(t *testing.T) {
+	resp := &planmodifier.StringResponse{PlanValue: types.StringUnknown()}
+	unknownWhenChanged(path.Root("trigger")).PlanModifyString(context.Background(), planmodifier.StringRequest{
+		PlanValue: types.StringUnknown(),
+		State:     tfsdk.State{Raw: nullRaw()},
+		Plan:      tfsdk.Plan{Raw: nonNullRaw()},
+	}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics on resource creation: %v", resp.Diagnostics)
+	}
+}
@@ -5,22 +5,27 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	polargo "github.com/polarsource/polar-go"
-	"github.com/polarsource/polar-go/retry"
 )
 
-// Compile-time interface conformance check.
-var _ provider.Provider = &PolarProvider{}
+// Compile-time interface conformance checks.
+var (
+	_ provider.Provider                       = &PolarProvider{}
+	_ provider.ProviderWithEphemeralResources = &PolarProvider{}
+)
 
 // PolarProvider defines the provider implementation.
 type PolarProvider struct {
@@ -29,38 +34,65 @@ type PolarProvider struct {
 
 // PolarProviderModel maps the HCL provider block into Go via `tfsdk` struct tags.
 type PolarProviderModel struct {
-	AccessToken types.String `tfsdk:"access_token"`
-	Server      types.String `tfsdk:"server"`
+	AccessToken    types.String      `tfsdk:"access_token"`
+	Server         types.String      `tfsdk:"server"`
+	OrganizationID types.String      `tfsdk:"organization_id"`
+	BaseURL        types.String      `tfsdk:"base_url"`
+	RequestTimeout types.String      `tfsdk:"request_timeout"`
+	CABundleFile   types.String      `tfsdk:"ca_bundle_file"`
+	ProxyURL       types.String      `tfsdk:"proxy_url"`
+	Retry          *RetryModel       `tfsdk:"retry"`
+	Consistency    *ConsistencyModel `tfsdk:"consistency"`
 }
 
 // PolarProviderData is passed to every resource/datasource via Configure().
-// Wraps the SDK client plus raw credentials for supplemental HTTP calls (SDK gaps).
+// Wraps the SDK client plus the supplemental client for SDK gaps.
 type PolarProviderData struct {
-	Client      *polargo.Polar
-	AccessToken string // needed for raw HTTP calls that bypass the SDK
-	ServerURL   string // base URL for raw HTTP calls (e.g. "https://api.polar.sh")
+	Client       *polargo.Polar
+	Supplemental *supplementalClient // raw JSON calls for endpoints and fields the SDK lacks
+	ServerURL    string              // base URL shared by both clients (e.g. "https://api.polar.sh")
+	HTTPClient   *http.Client        // shared with the SDK; carries timeout, CA and proxy settings
+
+	// Retry and Consistency hold the resolved retry and consistency attributes.
+	Retry       retryPolicy
+	Consistency consistencyPolicy
+
+	// OrganizationID is the provider-level default organization, or "" to let
+	// the API infer it from an organization-scoped access token.
+	OrganizationID string
 
-	// Singleton guard: only one polar_organization resource per provider.
-	orgOnce sync.Once
-	orgID   string
+	// Singleton guard: only one polar_organization resource per organization.
+	orgMu     sync.Mutex
+	orgClaims map[string]bool
 }
 
 // ClaimOrganization enforces that at most one polar_organization resource
-// exists per provider. The first call succeeds and records the org ID;
-// subsequent calls with a different ID return an error.
+// manages each organization. The first claim for an ID succeeds; further
+// claims for the same ID return an error until it is released.
 func (pd *PolarProviderData) ClaimOrganization(id string) error {
-	pd.orgOnce.Do(func() {
-		pd.orgID = id
-	})
-	if pd.orgID != id {
+	pd.orgMu.Lock()
+	defer pd.orgMu.Unlock()
+	if pd.orgClaims[id] {
 		return fmt.Errorf(
-			"only one polar_organization resource is allowed per provider (already managing %s)",
-			pd.orgID,
+			"only one polar_organization resource is allowed per organization (%s is already managed by this provider)",
+			id,
 		)
 	}
+	if pd.orgClaims == nil {
+		pd.orgClaims = make(map[string]bool)
+	}
+	pd.orgClaims[id] = true
 	return nil
 }
 
+// ReleaseOrganization drops the claim on an organization, e.g. when its
+// polar_organization resource is destroyed or replaced.
+func (pd *PolarProviderData) ReleaseOrganization(id string) {
+	pd.orgMu.Lock()
+	defer pd.orgMu.Unlock()
+	delete(pd.orgClaims, id)
+}
+
 func (p *PolarProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "polar"
 	resp.Version = p.version
@@ -71,17 +103,49 @@ func (p *PolarProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 		MarkdownDescription: "The Polar provider enables Terraform to manage [Polar.sh](https://polar.sh) resources such as products, meters, benefits, and webhook endpoints.",
 		Attributes: map[string]schema.Attribute{
 			"access_token": schema.StringAttribute{
-				MarkdownDescription: "Polar organization access token. Can also be set with the `POLAR_ACCESS_TOKEN` environment variable.",
+				MarkdownDescription: "Polar organization access token, or a personal access token when managing several organizations. Can also be set with the `POLAR_ACCESS_TOKEN` environment variable.",
 				Optional:            true,
 				Sensitive:           true,
 			},
 			"server": schema.StringAttribute{
-				MarkdownDescription: "The Polar environment to use. Must be `production` or `sandbox`. Can also be set with the `POLAR_SERVER` environment variable.",
+				MarkdownDescription: "The Polar environment to use. Must be `production` or `sandbox`. Not required when `base_url` is set. Can also be set with the `POLAR_SERVER` environment variable.",
 				Optional:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOf("production", "sandbox"),
 				},
 			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The default organization for resources and data sources that don't set their own `organization_id`. " +
+					"Not needed with an organization access token, which is scoped to one organization. " +
+					"Required with a personal access token that can access several organizations, unless every resource sets `organization_id`. " +
+					"Can also be set with the `POLAR_ORGANIZATION_ID` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Overrides the API base URL for every request, e.g. `http://localhost:8080` for a recording proxy or a local Polar stand-in. " +
+					"Takes precedence over `server`. Can also be set with the `POLAR_BASE_URL` environment variable.",
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Timeout for each HTTP request, as a duration such as `30s` or `2m`. Defaults to `%s`. ", formatDuration(defaultRequestTimeout)) +
+					"Retries of rate-limited or failed requests each get their own timeout. Can also be set with the `POLAR_REQUEST_TIMEOUT` environment variable.",
+				Optional: true,
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM file of additional CA certificates to trust, e.g. for a TLS-intercepting proxy. " +
+					"The system roots stay trusted. Can also be set with the `POLAR_CA_BUNDLE_FILE` environment variable.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "HTTP or HTTPS proxy for every request. Defaults to the standard `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables. " +
+					"Can also be set with the `POLAR_PROXY_URL` environment variable.",
+				Optional: true,
+			},
+			"retry":       retrySchemaAttribute(),
+			"consistency": consistencySchemaAttribute(),
 		},
 	}
 }
@@ -98,14 +162,11 @@ func (p *PolarProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	}
 
 	// Resolve access token: config value takes precedence over env var.
-	accessToken := data.AccessToken.ValueString()
-	if accessToken == "" {
-		accessToken = os.Getenv("POLAR_ACCESS_TOKEN")
-	}
+	accessToken := configOrEnv(data.AccessToken, "POLAR_ACCESS_TOKEN")
 	if accessToken == "" {
 		resp.Diagnostics.AddError(
 			"Missing Polar Access Token",
-			"The provider requires a Polar organization access token. "+
+			"The provider requires a Polar organization or personal access token. "+
 				"Set it in the provider configuration or via the POLAR_ACCESS_TOKEN environment variable.",
 		)
 		return
@@ -115,62 +176,108 @@ func (p *PolarProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		polargo.WithSecurity(accessToken),
 	}
 
-	// Resolve server: config value takes precedence over env var.
-	serverStr := data.Server.ValueString()
-	if serverStr == "" {
-		serverStr = os.Getenv("POLAR_SERVER")
+	// Resolve base URL: an explicit base_url (config, then env var) replaces
+	// the server environment for both the SDK and raw HTTP calls.
+	serverURL := configOrEnv(data.BaseURL, "POLAR_BASE_URL")
+	if serverURL != "" {
+		baseURL, err := resolveBaseURL(serverURL)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("base_url"), "Invalid Polar Base URL", err.Error())
+			return
+		}
+		serverURL = baseURL
+		opts = append(opts, polargo.WithServerURL(serverURL))
+	} else {
+		// Resolve server: config value takes precedence over env var.
+		serverStr := configOrEnv(data.Server, "POLAR_SERVER")
+		if serverStr == "" {
+			resp.Diagnostics.AddError(
+				"Missing Polar Server",
+				"The provider requires a server environment (`production` or `sandbox`) or a `base_url`. "+
+					"Set it in the provider configuration or via the POLAR_SERVER environment variable.",
+			)
+			return
+		}
+		if serverStr != "production" && serverStr != "sandbox" {
+			resp.Diagnostics.AddError(
+				"Invalid Polar Server",
+				"The server must be `production` or `sandbox`, got: "+serverStr,
+			)
+			return
+		}
+
+		server := polargo.ServerSandbox
+		serverURL = sandboxBaseURL
+		if serverStr == "production" {
+			server = polargo.ServerProduction
+			serverURL = productionBaseURL
+		}
+		opts = append(opts, polargo.WithServer(server))
 	}
-	if serverStr == "" {
-		resp.Diagnostics.AddError(
-			"Missing Polar Server",
-			"The provider requires a server environment (`production` or `sandbox`). "+
-				"Set it in the provider configuration or via the POLAR_SERVER environment variable.",
-		)
-		return
+
+	// One HTTP client for the SDK and raw HTTP calls, so timeout, CA and
+	// proxy settings and the User-Agent apply to every request.
+	clientConfig := httpClientConfig{
+		CABundleFile: configOrEnv(data.CABundleFile, "POLAR_CA_BUNDLE_FILE"),
+		ProxyURL:     configOrEnv(data.ProxyURL, "POLAR_PROXY_URL"),
+		UserAgent:    providerUserAgent(p.version, req.TerraformVersion),
 	}
-	if serverStr != "production" && serverStr != "sandbox" {
+	if raw := configOrEnv(data.RequestTimeout, "POLAR_REQUEST_TIMEOUT"); raw != "" {
+		timeout, err := parseRequestTimeout(raw)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("request_timeout"), "Invalid Request Timeout", err.Error())
+			return
+		}
+		clientConfig.Timeout = timeout
+	}
+	httpClient, err := newHTTPClient(clientConfig)
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Invalid Polar Server",
-			"The server must be `production` or `sandbox`, got: "+serverStr,
+			"Invalid HTTP Client Settings",
+			"Could not configure the HTTP client from ca_bundle_file and proxy_url: "+err.Error(),
 		)
 		return
 	}
+	opts = append(opts, polargo.WithClient(httpClient))
 
-	server := polargo.ServerSandbox
-	if serverStr == "production" {
-		server = polargo.ServerProduction
+	// Retry for rate limits (429) and server errors (5xx), shared with
+	// doWithRetry, and read-after-write polling for pollForConsistency.
+	retryPolicy := retryPolicyFromModel(data.Retry, &resp.Diagnostics)
+	consistency := consistencyPolicyFromModel(data.Consistency, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	opts = append(opts, polargo.WithServer(server))
-
-	// Built-in retry for rate limits (429) and server errors (5xx).
-	opts = append(opts, polargo.WithRetryConfig(retry.Config{
-		Strategy: "backoff",
-		Backoff: &retry.BackoffStrategy{
-			InitialInterval: 500,
-			MaxInterval:     30000,
-			Exponent:        1.5,
-			MaxElapsedTime:  120000,
-		},
-		RetryConnectionErrors: false,
-	}))
+	opts = append(opts, polargo.WithRetryConfig(retryPolicy.sdkConfig()))
 
 	client := polargo.New(opts...)
 
-	// Base URL for raw HTTP calls that bypass the SDK.
-	serverURL := "https://sandbox-api.polar.sh"
-	if server == polargo.ServerProduction {
-		serverURL = "https://api.polar.sh"
-	}
+	// Resolve default organization: config value takes precedence over env var.
+	organizationID := configOrEnv(data.OrganizationID, "POLAR_ORGANIZATION_ID")
 
 	// Package everything into PolarProviderData and hand it to Terraform.
-	// Resources receive this via resp.ResourceData, datasources via resp.DataSourceData.
+	// Resources receive this via resp.ResourceData, datasources via resp.DataSourceData,
+	// ephemeral resources via resp.EphemeralResourceData.
 	providerData := &PolarProviderData{
-		Client:      client,
-		AccessToken: accessToken,
-		ServerURL:   serverURL,
+		Client:         client,
+		Supplemental:   newSupplementalClient(serverURL, accessToken, httpClient, retryPolicy),
+		ServerURL:      serverURL,
+		HTTPClient:     httpClient,
+		Retry:          retryPolicy,
+		Consistency:    consistency,
+		OrganizationID: organizationID,
 	}
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
+}
+
+// configOrEnv returns the configured value, falling back to the environment
+// variable when the attribute is unset.
+func configOrEnv(value types.String, envVar string) string {
+	if v := value.ValueString(); v != "" {
+		return v
+	}
+	return os.Getenv(envVar)
 }
 
 // Resources returns constructors for all managed resources.
@@ -181,7 +288,11 @@ func (p *PolarProvider) Resources(ctx context.Context) []func() resource.Resourc
 		NewMeterResource,
 		NewBenefitResource,
 		NewProductResource,
+		NewDiscountResource,
+		NewCheckoutLinkResource,
+		NewCustomFieldResource,
 		NewOrganizationResource,
+		NewOrganizationAccessTokenResource,
 	}
 }
 
@@ -190,6 +301,19 @@ func (p *PolarProvider) DataSources(ctx context.Context) []func() datasource.Dat
 	return []func() datasource.DataSource{
 		NewMeterDataSource,
 		NewBenefitDataSource,
+		NewProductDataSource,
+		NewProductsDataSource,
+		NewMetersDataSource,
+		NewBenefitsDataSource,
+		NewWebhookEventTypesDataSource,
+		NewWebhookDeliveriesDataSource,
+	}
+}
+
+// EphemeralResources returns constructors for all ephemeral resources.
+func (p *PolarProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewOrganizationAccessTokenEphemeralResource,
 	}
 }
 
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// --- Provider HTTP settings ---
+// The SDK and the raw HTTP calls that fill SDK gaps share one *http.Client,
+// so base_url, request_timeout, ca_bundle_file and proxy_url apply to every
+// request the provider makes.
+
+// defaultRequestTimeout matches the timeout polar-go uses for its own default client.
+const defaultRequestTimeout = 60 * time.Second
+
+// Base URLs for the two Polar environments, used by raw HTTP calls. The SDK
+// carries the same list internally; base_url overrides both.
+const (
+	productionBaseURL = "https://api.polar.sh"
+	sandboxBaseURL    = "https://sandbox-api.polar.sh"
+)
+
+// httpClientConfig holds the resolved transport settings from the provider block.
+type httpClientConfig struct {
+	Timeout      time.Duration
+	CABundleFile string // PEM file of extra trusted CAs, or "" for the system pool
+	ProxyURL     string // explicit proxy, or "" to honor HTTP(S)_PROXY / NO_PROXY
+	UserAgent    string // see providerUserAgent
+}
+
+// newHTTPClient builds the client shared by the SDK and raw HTTP calls.
+func newHTTPClient(cfg httpClientConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if cfg.CABundleFile != "" {
+		pem, err := os.ReadFile(cfg.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA bundle: %w", err)
+		}
+		// Add to the system roots rather than replacing them, so a proxy's CA
+		// can be trusted without breaking direct calls to the real API.
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("CA bundle %s contains no PEM certificates", cfg.CABundleFile)
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	if cfg.ProxyURL != "" {
+		proxy, err := parseHTTPURL(cfg.ProxyURL, "proxy_url")
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultRequestTimeout
+	}
+	return &http.Client{
+		Transport: &tracingTransport{base: transport, userAgent: cfg.UserAgent},
+		Timeout:   timeout,
+	}, nil
+}
+
+// resolveBaseURL validates a base_url override and strips any trailing slash,
+// since request paths are appended as "/v1/...".
+func resolveBaseURL(raw string) (string, error) {
+	u, err := parseHTTPURL(raw, "base_url")
+	if err != nil {
+		return "", err
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("base_url must not contain a query or fragment, got %q", raw)
+	}
+	return strings.TrimRight(u.String(), "/"), nil
+}
+
+// parseRequestTimeout parses a request_timeout duration such as "30s" or "2m".
+func parseRequestTimeout(raw string) (time.Duration, error) {
+	timeout, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("request_timeout must be a duration such as \"30s\" or \"2m\", got %q", raw)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("request_timeout must be positive, got %q", raw)
+	}
+	return timeout, nil
+}
+
+// parseHTTPURL parses an absolute http or https URL for the named attribute.
+func parseHTTPURL(raw, attribute string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a valid URL: %w", attribute, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%s must be an absolute http or https URL, got %q", attribute, raw)
+	}
+	return u, nil
+}
+
+// --- Request tracing ---
+// Every request, from the SDK or the supplemental client, goes through
+// tracingTransport: it identifies the provider in the User-Agent and logs the
+// request at debug level. Terraform operations tag their context with a
+// correlation ID, so all the requests one operation sends can be found
+// together in TF_LOG=DEBUG output.
+
+// correlationIDField is the tflog field holding an operation's correlation ID.
+const correlationIDField = "polar_correlation_id"
+
+// withCorrelationID tags ctx with a new correlation ID for one Terraform
+// operation (a create, read, update or delete). Log entries written with the
+// returned context, including tracingTransport's, carry it.
+func withCorrelationID(ctx context.Context) context.Context {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return tflog.SetField(ctx, correlationIDField, hex.EncodeToString(b))
+}
+
+// providerUserAgent identifies the provider and, when known, the Terraform
+// version driving it, e.g. "terraform-provider-polar/0.2.0 terraform/1.9.5".
+func providerUserAgent(providerVersion, terraformVersion string) string {
+	ua := "terraform-provider-polar/" + providerVersion
+	if terraformVersion != "" {
+		ua += " terraform/" + terraformVersion
+	}
+	return ua
+}
+
+// tracingTransport sets the provider's User-Agent on every request and logs
+// method, path, status and latency at debug level.
+type tracingTransport struct {
+	base      http.RoundTripper
+	userAgent string
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the caller's request.
+	req = req.Clone(req.Context())
+	if t.userAgent != "" {
+		// Keep the SDK's own User-Agent after ours.
+		ua := t.userAgent
+		if existing := req.Header.Get("User-Agent"); existing != "" {
+			ua += " " + existing
+		}
+		req.Header.Set("User-Agent", ua)
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+
+	ctx := tflog.MaskAllFieldValuesRegexes(req.Context(), polarSecretPattern)
+	fields := map[string]interface{}{
+		"method":        req.Method,
+		"path":          req.URL.Path,
+		"latency_ms":    time.Since(start).Milliseconds(),
+		"authorization": redactAuthorization(req.Header.Get("Authorization")),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Polar API request failed", fields)
+		return nil, err
+	}
+	fields["status"] = resp.StatusCode
+	tflog.Debug(ctx, "Polar API request", fields)
+	return resp, nil
+}
+
+// redactAuthorization hides the credential in an Authorization header value,
+// keeping only the scheme.
+func redactAuthorization(value string) string {
+	if value == "" {
+		return ""
+	}
+	scheme, _, _ := strings.Cut(value, " ")
+	return scheme + " [REDACTED]"
+}
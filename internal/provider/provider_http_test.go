@@ -0,0 +1,259 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestResolveBaseURL(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{raw: "http://localhost:8080", want: "http://localhost:8080"},
+		{raw: "https://polar.internal.example/", want: "https://polar.internal.example"},
+		{raw: "https://proxy.example/polar/", want: "https://proxy.example/polar"},
+		{raw: "localhost:8080", wantErr: true},
+		{raw: "ftp://polar.example", wantErr: true},
+		{raw: "/v1", wantErr: true},
+		{raw: "https://polar.example?debug=1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := resolveBaseURL(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveBaseURL(%q) error = %v, wantErr %v", tt.raw, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("resolveBaseURL(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRequestTimeout(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    time.Duration
+		wantErr bool
+	}{
+		{raw: "30s", want: 30 * time.Second},
+		{raw: "2m", want: 2 * time.Minute},
+		{raw: "1m30s", want: 90 * time.Second},
+		{raw: "30", wantErr: true},
+		{raw: "0s", wantErr: true},
+		{raw: "-5s", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := parseRequestTimeout(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRequestTimeout(%q) error = %v, wantErr %v", tt.raw, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseRequestTimeout(%q) = %s, want %s", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClient_timeout(t *testing.T) {
+	client, err := newHTTPClient(httpClientConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.Timeout != defaultRequestTimeout {
+		t.Errorf("default Timeout = %s, want %s", client.Timeout, defaultRequestTimeout)
+	}
+
+	client, err = newHTTPClient(httpClientConfig{Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.Timeout != 5*time.Second {
+		t.Errorf("Timeout = %s, want 5s", client.Timeout)
+	}
+}
+
+func TestNewHTTPClient_caBundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	// Without the bundle the test server's self-signed certificate is rejected.
+	client, err := newHTTPClient(httpClientConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := client.Get(server.URL); err == nil {
+		resp.Body.Close()
+		t.Fatal("request without CA bundle succeeded, want certificate error")
+	}
+
+	bundle := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(bundle, certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	client, err = newHTTPClient(httpClientConfig{CABundleFile: bundle})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("request with CA bundle: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestNewHTTPClient_invalidCABundle(t *testing.T) {
+	dir := t.TempDir()
+	notPEM := filepath.Join(dir, "not.pem")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, file := range map[string]string{
+		"missing file": filepath.Join(dir, "missing.pem"),
+		"no PEM data":  notPEM,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := newHTTPClient(httpClientConfig{CABundleFile: file}); err == nil {
+				t.Error("newHTTPClient() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestNewHTTPClient_proxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A forward proxy receives the absolute target URL.
+		proxied = r.URL.String()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer proxy.Close()
+
+	client, err := newHTTPClient(httpClientConfig{ProxyURL: proxy.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Get("http://api.polar.invalid/v1/meters/")
+	if err != nil {
+		t.Fatalf("request through proxy: %v", err)
+	}
+	resp.Body.Close()
+	if proxied != "http://api.polar.invalid/v1/meters/" {
+		t.Errorf("proxy saw %q, want the absolute target URL", proxied)
+	}
+
+	if _, err := newHTTPClient(httpClientConfig{ProxyURL: "socks://proxy:1080"}); err == nil {
+		t.Error("unsupported proxy scheme: error = nil, want error")
+	}
+}
+
+func TestProviderUserAgent(t *testing.T) {
+	if got := providerUserAgent("0.2.0", "1.9.5"); got != "terraform-provider-polar/0.2.0 terraform/1.9.5" {
+		t.Errorf("providerUserAgent = %q", got)
+	}
+	if got := providerUserAgent("dev", ""); got != "terraform-provider-polar/dev" {
+		t.Errorf("providerUserAgent without a Terraform version = %q", got)
+	}
+}
+
+func TestTracingTransport(t *testing.T) {
+	var userAgents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	defer server.Close()
+
+	client, err := newHTTPClient(httpClientConfig{UserAgent: "terraform-provider-polar/test terraform/1.9.5"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two requests from one operation, the second with the SDK's User-Agent,
+	// then one from another operation.
+	var logs strings.Builder
+	root := tflogtest.RootLogger(context.Background(), &logs)
+	operation := withCorrelationID(root)
+	for i, ctx := range []context.Context{operation, operation, withCorrelationID(root)} {
+		sdkUserAgent := ""
+		if i == 1 {
+			sdkUserAgent = "speakeasy-sdk/go"
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/v1/meters/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer polar_oat_secret")
+		if sdkUserAgent != "" {
+			req.Header.Set("User-Agent", sdkUserAgent)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if ua := req.Header.Get("User-Agent"); ua != sdkUserAgent {
+			t.Errorf("caller's request User-Agent changed to %q", ua)
+		}
+	}
+
+	wantAgents := []string{
+		"terraform-provider-polar/test terraform/1.9.5",
+		"terraform-provider-polar/test terraform/1.9.5 speakeasy-sdk/go",
+		"terraform-provider-polar/test terraform/1.9.5",
+	}
+	if strings.Join(userAgents, "|") != strings.Join(wantAgents, "|") {
+		t.Errorf("User-Agents = %q, want %q", userAgents, wantAgents)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(strings.NewReader(logs.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("got %d log entries, want 3:\n%s", len(entries), logs.String())
+	}
+	for i, entry := range entries {
+		if entry["method"] != http.MethodGet || entry["path"] != "/v1/meters/" || entry["status"] != float64(http.StatusTeapot) {
+			t.Errorf("entry %d = %v, want method, path and status", i, entry)
+		}
+		if _, ok := entry["latency_ms"].(float64); !ok {
+			t.Errorf("entry %d has no latency_ms: %v", i, entry)
+		}
+		if entry["authorization"] != "Bearer [REDACTED]" {
+			t.Errorf("entry %d authorization = %v, want it redacted", i, entry["authorization"])
+		}
+		if id, _ := entry[correlationIDField].(string); id == "" {
+			t.Errorf("entry %d has no correlation ID: %v", i, entry)
+		}
+	}
+	if ids := []any{entries[0][correlationIDField], entries[1][correlationIDField], entries[2][correlationIDField]}; ids[0] != ids[1] || ids[1] == ids[2] {
+		t.Errorf("correlation IDs = %v, want one per operation", ids)
+	}
+	if strings.Contains(logs.String(), "polar_oat_secret") {
+		t.Errorf("logs contain the access token:\n%s", logs.String())
+	}
+}
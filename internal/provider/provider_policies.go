@@ -0,0 +1,301 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/polarsource/polar-go/models/operations"
+	"github.com/polarsource/polar-go/retry"
+)
+
+// --- Retry and consistency policies ---
+// Both the SDK and doWithRetry back off on 429 and 5xx responses, and every
+// write polls for read-after-write consistency. The defaults suit the
+// production API; the provider's retry and consistency attributes let slow
+// environments (e.g. a busy sandbox) wait longer.
+
+// retryPolicy controls exponential backoff for rate-limited and failed requests.
+type retryPolicy struct {
+	InitialInterval       time.Duration
+	MaxInterval           time.Duration
+	Exponent              float64
+	MaxElapsed            time.Duration
+	RetryConnectionErrors bool
+	// Clock times the backoff; nil uses the system clock.
+	Clock clock
+}
+
+var defaultRetryPolicy = retryPolicy{
+	InitialInterval: 500 * time.Millisecond,
+	MaxInterval:     30 * time.Second,
+	Exponent:        1.5,
+	MaxElapsed:      120 * time.Second,
+}
+
+// sdkConfig converts the policy to polar-go's retry configuration.
+func (p retryPolicy) sdkConfig() retry.Config {
+	return retry.Config{
+		Strategy: "backoff",
+		Backoff: &retry.BackoffStrategy{
+			InitialInterval: int(p.InitialInterval.Milliseconds()),
+			MaxInterval:     int(p.MaxInterval.Milliseconds()),
+			Exponent:        p.Exponent,
+			MaxElapsedTime:  int(p.MaxElapsed.Milliseconds()),
+		},
+		RetryConnectionErrors: p.RetryConnectionErrors,
+	}
+}
+
+// createOption returns the per-call retry option for SDK create calls. A create
+// that fails before a response arrives may still have succeeded, so retrying
+// it could create a duplicate: connection errors are never retried, whatever
+// RetryConnectionErrors says. supplementalClient.do does the same for POSTs.
+func (p retryPolicy) createOption() operations.Option {
+	p.RetryConnectionErrors = false
+	return operations.WithRetries(p.sdkConfig())
+}
+
+// consistencyPolicy controls read-after-write polling in pollForConsistency.
+type consistencyPolicy struct {
+	MaxAttempts int
+	Interval    time.Duration
+	// Strict turns a read-back that never converges into an error instead
+	// of a warning.
+	Strict bool
+	// Clock times the polling interval; nil uses the system clock.
+	Clock clock
+}
+
+var defaultConsistencyPolicy = consistencyPolicy{
+	MaxAttempts: 10,
+	Interval:    500 * time.Millisecond,
+}
+
+// clock is the time source for retry backoff and consistency polling. Tests
+// substitute one whose waits return at once, so timing-dependent paths run
+// deterministically.
+type clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock is the real clock.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// clockOrSystem returns c, or the system clock when c is nil.
+func clockOrSystem(c clock) clock {
+	if c == nil {
+		return systemClock{}
+	}
+	return c
+}
+
+// RetryModel maps the provider's retry attribute.
+type RetryModel struct {
+	InitialInterval       types.String  `tfsdk:"initial_interval"`
+	MaxInterval           types.String  `tfsdk:"max_interval"`
+	Exponent              types.Float64 `tfsdk:"exponent"`
+	MaxElapsed            types.String  `tfsdk:"max_elapsed"`
+	RetryConnectionErrors types.Bool    `tfsdk:"retry_connection_errors"`
+}
+
+// ConsistencyModel maps the provider's consistency attribute.
+type ConsistencyModel struct {
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
+	Interval    types.String `tfsdk:"interval"`
+	Strict      types.Bool   `tfsdk:"strict"`
+}
+
+func retrySchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Backoff for requests that fail with a rate limit (429) or server error (5xx). " +
+			"Applies to every request the provider makes. Omitted attributes keep their defaults.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"initial_interval": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Wait before the first retry, as a duration. Defaults to `%s`.", formatDuration(defaultRetryPolicy.InitialInterval)),
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+			},
+			"max_interval": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Longest wait between retries, as a duration. Defaults to `%s`.", formatDuration(defaultRetryPolicy.MaxInterval)),
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+			},
+			"exponent": schema.Float64Attribute{
+				MarkdownDescription: fmt.Sprintf("Factor the wait grows by after each retry. Defaults to `%g`.", defaultRetryPolicy.Exponent),
+				Optional:            true,
+				Validators:          []validator.Float64{float64validator.AtLeast(1)},
+			},
+			"max_elapsed": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Give up retrying a request after this long, as a duration. Defaults to `%s`.", formatDuration(defaultRetryPolicy.MaxElapsed)),
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+			},
+			"retry_connection_errors": schema.BoolAttribute{
+				MarkdownDescription: "Also retry requests that fail before a response arrives, such as connection resets and timeouts. " +
+					"Requests that create something, such as products or access tokens, are never retried this way, so a lost response can't create a duplicate. Defaults to `false`.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func consistencySchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Polling after each write until Polar's eventually consistent reads reflect it. Omitted attributes keep their defaults.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"max_attempts": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("How many times to read a resource back after a write. Defaults to `%d`.", defaultConsistencyPolicy.MaxAttempts),
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"interval": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Wait between read-backs, as a duration. Defaults to `%s`.", formatDuration(defaultConsistencyPolicy.Interval)),
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+			},
+			"strict": schema.BoolAttribute{
+				MarkdownDescription: "Fail the apply when the read-back after a write never reflects it, instead of saving the stale result with a warning. " +
+					"Resources created this way are saved as tainted, so the next apply replaces them. Each resource can override this with its own `consistency.strict`. Defaults to `false`.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// ConsistencyOverrideModel maps a resource's consistency attribute, which
+// overrides the provider's consistency settings for that resource.
+type ConsistencyOverrideModel struct {
+	Strict types.Bool `tfsdk:"strict"`
+}
+
+// consistencyOverrideAttribute returns the consistency attribute shared by
+// resources that poll for read-after-write consistency. It only affects how
+// the provider waits, so it is never sent to the API; data sources drop it
+// (see resourceSchemaAttributes).
+func consistencyOverrideAttribute() rschema.SingleNestedAttribute {
+	return rschema.SingleNestedAttribute{
+		MarkdownDescription: "Overrides the provider's `consistency` settings for this resource.",
+		Optional:            true,
+		Attributes: map[string]rschema.Attribute{
+			"strict": rschema.BoolAttribute{
+				MarkdownDescription: "Fail the apply when the read-back after a write never reflects it, instead of saving the stale result with a warning. " +
+					"A resource created this way is saved as tainted, so the next apply replaces it. Defaults to the provider's `consistency.strict`.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// withOverride applies a resource's consistency attribute on top of the
+// provider policy. o is nil when the attribute isn't set.
+func (p consistencyPolicy) withOverride(o *ConsistencyOverrideModel) consistencyPolicy {
+	if o != nil && !o.Strict.IsNull() && !o.Strict.IsUnknown() {
+		p.Strict = o.Strict.ValueBool()
+	}
+	return p
+}
+
+// retryPolicyFromModel overlays the configured retry attributes on the defaults.
+func retryPolicyFromModel(m *RetryModel, diags *diag.Diagnostics) retryPolicy {
+	p := defaultRetryPolicy
+	if m == nil {
+		return p
+	}
+	base := path.Root("retry")
+	setDuration(&p.InitialInterval, m.InitialInterval, base.AtName("initial_interval"), diags)
+	setDuration(&p.MaxInterval, m.MaxInterval, base.AtName("max_interval"), diags)
+	setDuration(&p.MaxElapsed, m.MaxElapsed, base.AtName("max_elapsed"), diags)
+	if !m.Exponent.IsNull() && !m.Exponent.IsUnknown() {
+		p.Exponent = m.Exponent.ValueFloat64()
+	}
+	if !m.RetryConnectionErrors.IsNull() && !m.RetryConnectionErrors.IsUnknown() {
+		p.RetryConnectionErrors = m.RetryConnectionErrors.ValueBool()
+	}
+	if p.MaxInterval < p.InitialInterval {
+		diags.AddAttributeError(base.AtName("max_interval"), "Invalid Retry Settings",
+			fmt.Sprintf("max_interval (%s) must not be shorter than initial_interval (%s).", p.MaxInterval, p.InitialInterval))
+	}
+	return p
+}
+
+// consistencyPolicyFromModel overlays the configured consistency attributes on the defaults.
+func consistencyPolicyFromModel(m *ConsistencyModel, diags *diag.Diagnostics) consistencyPolicy {
+	p := defaultConsistencyPolicy
+	if m == nil {
+		return p
+	}
+	setDuration(&p.Interval, m.Interval, path.Root("consistency").AtName("interval"), diags)
+	if !m.MaxAttempts.IsNull() && !m.MaxAttempts.IsUnknown() {
+		p.MaxAttempts = int(m.MaxAttempts.ValueInt64())
+	}
+	if !m.Strict.IsNull() && !m.Strict.IsUnknown() {
+		p.Strict = m.Strict.ValueBool()
+	}
+	return p
+}
+
+// setDuration parses value into *d when it is set, leaving the default otherwise.
+func setDuration(d *time.Duration, value types.String, p path.Path, diags *diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	parsed, err := time.ParseDuration(value.ValueString())
+	if err != nil || parsed <= 0 {
+		diags.AddAttributeError(p, "Invalid Duration",
+			fmt.Sprintf("Expected a positive duration such as \"500ms\" or \"2m\", got %q.", value.ValueString()))
+		return
+	}
+	*d = parsed
+}
+
+// formatDuration renders a duration the way users write it, e.g. "2m"
+// rather than time.Duration's "2m0s".
+func formatDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+// durationValidator checks that a string is a positive Go duration, e.g. "30s".
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration such as \"500ms\", \"30s\" or \"2m\""
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if d, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Duration",
+			fmt.Sprintf("Expected a positive duration such as \"500ms\" or \"2m\", got %q.", req.ConfigValue.ValueString()))
+	}
+}
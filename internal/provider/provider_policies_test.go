@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/polarsource/polar-go/models/operations"
+)
+
+func TestRetryPolicy_sdkConfig(t *testing.T) {
+	cfg := retryPolicy{
+		InitialInterval:       250 * time.Millisecond,
+		MaxInterval:           time.Minute,
+		Exponent:              2,
+		MaxElapsed:            5 * time.Minute,
+		RetryConnectionErrors: true,
+	}.sdkConfig()
+
+	if cfg.Strategy != "backoff" || cfg.Backoff == nil {
+		t.Fatalf("sdkConfig() = %+v, want backoff strategy", cfg)
+	}
+	if cfg.Backoff.InitialInterval != 250 || cfg.Backoff.MaxInterval != 60000 || cfg.Backoff.MaxElapsedTime != 300000 {
+		t.Errorf("Backoff intervals = %+v, want 250/60000/300000 ms", cfg.Backoff)
+	}
+	if cfg.Backoff.Exponent != 2 || !cfg.RetryConnectionErrors {
+		t.Errorf("sdkConfig() = %+v, want exponent 2 and connection retries", cfg)
+	}
+}
+
+func TestRetryPolicy_createOption(t *testing.T) {
+	policy := retryPolicy{
+		InitialInterval:       250 * time.Millisecond,
+		MaxInterval:           time.Minute,
+		Exponent:              2,
+		MaxElapsed:            5 * time.Minute,
+		RetryConnectionErrors: true,
+	}
+
+	var opts operations.Options
+	if err := policy.createOption()(&opts, operations.SupportedOptionRetries); err != nil {
+		t.Fatalf("applying createOption: %v", err)
+	}
+	if opts.Retries == nil || opts.Retries.Backoff == nil || opts.Retries.Backoff.MaxElapsedTime != 300000 {
+		t.Fatalf("Retries = %+v, want the policy's backoff", opts.Retries)
+	}
+	if opts.Retries.RetryConnectionErrors {
+		t.Error("create calls retry connection errors, want them never retried")
+	}
+	if !policy.RetryConnectionErrors {
+		t.Error("createOption changed the provider's policy")
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := map[time.Duration]string{
+		500 * time.Millisecond: "500ms",
+		30 * time.Second:       "30s",
+		2 * time.Minute:        "2m",
+		90 * time.Second:       "1m30s",
+		time.Hour:              "1h",
+	}
+	for d, want := range tests {
+		if got := formatDuration(d); got != want {
+			t.Errorf("formatDuration(%s) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	tests := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{value: types.StringValue("500ms")},
+		{value: types.StringValue("2m")},
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue("5"), wantErr: true},
+		{value: types.StringValue("0s"), wantErr: true},
+		{value: types.StringValue("soon"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value.String(), func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("interval"), ConfigValue: tt.value}
+			var resp validator.StringResponse
+			durationValidator{}.ValidateString(context.Background(), req, &resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("HasError() = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// staleMeter is a Timestamped stub that never catches up with the write.
+type staleMeter struct{ created time.Time }
+
+func (m staleMeter) GetCreatedAt() time.Time   { return m.created }
+func (m staleMeter) GetModifiedAt() *time.Time { return nil }
+
+// fakeClock is a clock whose waits return at once, advancing its time by
+// the wait instead of sleeping. It records every wait.
+type fakeClock struct {
+	now   time.Time
+	waits []time.Duration
+	// cancel, when set, is called on the first wait, whose channel then
+	// never fires, as if the context were cancelled mid-wait.
+	cancel context.CancelFunc
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.waits = append(c.waits, d)
+	ch := make(chan time.Time, 1)
+	if c.cancel != nil {
+		c.cancel()
+		return ch
+	}
+	c.now = c.now.Add(d)
+	ch <- c.now
+	return ch
+}
+
+func TestPollForConsistency_policy(t *testing.T) {
+	write := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	stale := staleMeter{created: write.Add(-time.Minute)}
+
+	for _, strict := range []bool{false, true} {
+		policy := consistencyPolicy{MaxAttempts: 3, Interval: time.Millisecond, Strict: strict}
+		var diags diag.Diagnostics
+		var fetches int
+		got, err := pollForConsistency(context.Background(), policy, "meter", "m_1", write, func() (staleMeter, error) {
+			fetches++
+			return stale, nil
+		}, &diags)
+
+		if err != nil {
+			t.Fatalf("strict=%v: err = %v, want the stale result without error", strict, err)
+		}
+		if got != stale {
+			t.Errorf("strict=%v: result = %+v, want the stale read", strict, got)
+		}
+		if fetches != policy.MaxAttempts {
+			t.Errorf("strict=%v: fetches = %d, want %d", strict, fetches, policy.MaxAttempts)
+		}
+		if diags.HasError() != strict || (diags.WarningsCount() == 1) == strict {
+			t.Errorf("strict=%v: diagnostics = %v, want an error only in strict mode", strict, diags)
+		}
+	}
+}
+
+func TestConsistencyPolicy_withOverride(t *testing.T) {
+	base := consistencyPolicy{MaxAttempts: 5, Interval: time.Second, Strict: true}
+	tests := []struct {
+		name     string
+		override *ConsistencyOverrideModel
+		want     bool
+	}{
+		{name: "unset", override: nil, want: true},
+		{name: "null strict", override: &ConsistencyOverrideModel{Strict: types.BoolNull()}, want: true},
+		{name: "lenient", override: &ConsistencyOverrideModel{Strict: types.BoolValue(false)}, want: false},
+		{name: "strict", override: &ConsistencyOverrideModel{Strict: types.BoolValue(true)}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := base.withOverride(tt.override)
+			if got.Strict != tt.want {
+				t.Errorf("Strict = %v, want %v", got.Strict, tt.want)
+			}
+			if got.MaxAttempts != base.MaxAttempts || got.Interval != base.Interval {
+				t.Errorf("withOverride changed polling settings: %+v", got)
+			}
+		})
+	}
+}
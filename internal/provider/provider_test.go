@@ -3,11 +3,24 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"slices"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/polarsource/polar-go/models/operations"
+	"github.com/sjkchang/terraform-provider-polar/internal/polartest"
 )
 
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
@@ -26,3 +39,223 @@ func testAccPreCheck(t *testing.T) {
 		t.Setenv("POLAR_SERVER", "sandbox")
 	}
 }
+
+// testFakeProviderConfig configures the provider for tests against the
+// polartest fake: the access token and base URL come from the environment
+// set by testFakeProviderFactories, and the retry and consistency intervals
+// are shortened so injected faults and lag don't slow the test down.
+const testFakeProviderConfig = `
+provider "polar" {
+  retry = {
+    initial_interval = "1ms"
+    max_interval     = "10ms"
+  }
+
+  consistency = {
+    interval = "1ms"
+  }
+}
+`
+
+// testFakeProviderFactories starts a polartest fake for the duration of the
+// test and points the provider at it through the environment, so offline
+// tests run without POLAR_ACCESS_TOKEN. Configs should start with
+// testFakeProviderConfig.
+func testFakeProviderFactories(t *testing.T) (*polartest.Server, map[string]func() (tfprotov6.ProviderServer, error)) {
+	t.Helper()
+	server := polartest.NewServer()
+	t.Cleanup(server.Close)
+
+	t.Setenv("POLAR_ACCESS_TOKEN", "polar_oat_offline")
+	t.Setenv("POLAR_BASE_URL", server.URL())
+	t.Setenv("POLAR_SERVER", "")
+	t.Setenv("POLAR_ORGANIZATION_ID", "")
+	return server, testAccProtoV6ProviderFactories
+}
+
+// configureTestProvider runs the provider's Configure with the given
+// attributes set and the rest null, returning the resulting provider data.
+// Values are Go strings, numbers and bools, with map[string]any for nested
+// attributes.
+func configureTestProvider(t *testing.T, attrs map[string]any) (*PolarProviderData, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	p := New("test")()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    testTerraformValue(t, schemaResp.Schema.Type().TerraformType(ctx), attrs),
+	}
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+	pd, _ := resp.ResourceData.(*PolarProviderData)
+	return pd, resp.Diagnostics
+}
+
+// testTerraformValue converts a Go value to a tftypes.Value of typ: maps
+// become objects, with missing attributes null, or maps, and slices become
+// lists or sets.
+func testTerraformValue(t *testing.T, typ tftypes.Type, v any) tftypes.Value {
+	t.Helper()
+	if v == nil {
+		return tftypes.NewValue(typ, nil)
+	}
+	switch v := v.(type) {
+	case map[string]any:
+		if m, ok := typ.(tftypes.Map); ok {
+			values := make(map[string]tftypes.Value, len(v))
+			for k, e := range v {
+				values[k] = testTerraformValue(t, m.ElementType, e)
+			}
+			return tftypes.NewValue(typ, values)
+		}
+		obj, ok := typ.(tftypes.Object)
+		if !ok {
+			t.Fatalf("got a map for non-object, non-map type %s", typ)
+		}
+		values := make(map[string]tftypes.Value, len(obj.AttributeTypes))
+		for name, attrType := range obj.AttributeTypes {
+			values[name] = testTerraformValue(t, attrType, v[name])
+		}
+		return tftypes.NewValue(typ, values)
+	case []any:
+		var elemType tftypes.Type
+		switch c := typ.(type) {
+		case tftypes.List:
+			elemType = c.ElementType
+		case tftypes.Set:
+			elemType = c.ElementType
+		default:
+			t.Fatalf("got a slice for non-collection type %s", typ)
+		}
+		elems := make([]tftypes.Value, 0, len(v))
+		for _, e := range v {
+			elems = append(elems, testTerraformValue(t, elemType, e))
+		}
+		return tftypes.NewValue(typ, elems)
+	case int:
+		return tftypes.NewValue(typ, new(big.Float).SetInt64(int64(v)))
+	case float64:
+		return tftypes.NewValue(typ, big.NewFloat(v))
+	default:
+		return tftypes.NewValue(typ, v)
+	}
+}
+
+func TestProviderConfigure_baseURL(t *testing.T) {
+	t.Setenv("POLAR_SERVER", "")
+	t.Setenv("POLAR_BASE_URL", "")
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"items": [], "pagination": {"total_count": 0, "max_page": 1}}`)
+	}))
+	defer server.Close()
+
+	pd, diags := configureTestProvider(t, map[string]any{
+		"access_token":    "polar_oat_test",
+		"base_url":        server.URL + "/",
+		"request_timeout": "5s",
+	})
+	if diags.HasError() {
+		t.Fatalf("Configure: %v", diags)
+	}
+	if pd.ServerURL != server.URL {
+		t.Errorf("ServerURL = %q, want %q", pd.ServerURL, server.URL)
+	}
+	if pd.HTTPClient.Timeout != 5*time.Second {
+		t.Errorf("HTTPClient.Timeout = %s, want 5s", pd.HTTPClient.Timeout)
+	}
+
+	// Both the SDK and raw HTTP calls must reach the base URL.
+	if _, err := pd.Client.Meters.List(context.Background(), operations.MetersListRequest{}); err != nil {
+		t.Fatalf("SDK request: %v", err)
+	}
+	if _, err := getOrgSupplemental(context.Background(), pd, "org_1"); err != nil {
+		t.Fatalf("raw request: %v", err)
+	}
+	want := []string{"/v1/meters/", "/v1/organizations/org_1"}
+	if !slices.Equal(paths, want) {
+		t.Errorf("requested paths = %v, want %v", paths, want)
+	}
+}
+
+func TestProviderConfigure_invalidSettings(t *testing.T) {
+	t.Setenv("POLAR_SERVER", "")
+	t.Setenv("POLAR_BASE_URL", "")
+
+	tests := map[string]map[string]any{
+		"no server or base_url": {},
+		"relative base_url":     {"base_url": "localhost:8080"},
+		"bad request_timeout":   {"server": "sandbox", "request_timeout": "10"},
+		"missing CA bundle":     {"server": "sandbox", "ca_bundle_file": "/nonexistent/ca.pem"},
+		"bad proxy_url":         {"server": "sandbox", "proxy_url": "proxy:3128"},
+		"bad retry interval":    {"server": "sandbox", "retry": map[string]any{"initial_interval": "soon"}},
+		"inverted retry bounds": {"server": "sandbox", "retry": map[string]any{"initial_interval": "1m", "max_interval": "10s"}},
+		"bad poll interval":     {"server": "sandbox", "consistency": map[string]any{"interval": "-1s"}},
+	}
+	for name, attrs := range tests {
+		t.Run(name, func(t *testing.T) {
+			attrs["access_token"] = "polar_oat_test"
+			if _, diags := configureTestProvider(t, attrs); !diags.HasError() {
+				t.Error("Configure succeeded, want error")
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_policies(t *testing.T) {
+	t.Setenv("POLAR_BASE_URL", "")
+
+	pd, diags := configureTestProvider(t, map[string]any{
+		"access_token": "polar_oat_test",
+		"server":       "sandbox",
+	})
+	if diags.HasError() {
+		t.Fatalf("Configure: %v", diags)
+	}
+	if pd.Retry != defaultRetryPolicy {
+		t.Errorf("Retry = %+v, want defaults %+v", pd.Retry, defaultRetryPolicy)
+	}
+	if pd.Consistency != defaultConsistencyPolicy {
+		t.Errorf("Consistency = %+v, want defaults %+v", pd.Consistency, defaultConsistencyPolicy)
+	}
+
+	pd, diags = configureTestProvider(t, map[string]any{
+		"access_token": "polar_oat_test",
+		"server":       "sandbox",
+		"retry": map[string]any{
+			"max_elapsed":             "10m",
+			"exponent":                2.0,
+			"retry_connection_errors": true,
+		},
+		"consistency": map[string]any{
+			"max_attempts": 30,
+			"interval":     "2s",
+			"strict":       true,
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("Configure: %v", diags)
+	}
+	wantRetry := retryPolicy{
+		InitialInterval:       defaultRetryPolicy.InitialInterval,
+		MaxInterval:           defaultRetryPolicy.MaxInterval,
+		Exponent:              2,
+		MaxElapsed:            10 * time.Minute,
+		RetryConnectionErrors: true,
+	}
+	if pd.Retry != wantRetry {
+		t.Errorf("Retry = %+v, want %+v", pd.Retry, wantRetry)
+	}
+	wantConsistency := consistencyPolicy{MaxAttempts: 30, Interval: 2 * time.Second, Strict: true}
+	if pd.Consistency != wantConsistency {
+		t.Errorf("Consistency = %+v, want %+v", pd.Consistency, wantConsistency)
+	}
+}
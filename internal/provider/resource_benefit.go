@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,7 +29,10 @@ func NewBenefitResource() resource.Resource {
 }
 
 type BenefitResource struct {
-	client *polargo.Polar
+	client         *polargo.Polar
+	organizationID string            // provider default, see resolveOrganizationID
+	consistency    consistencyPolicy // provider consistency settings, see pollForConsistency
+	retry          retryPolicy       // provider retry settings, see retryPolicy.createOption
 }
 
 // --- Terraform model types ---
@@ -37,6 +41,7 @@ type BenefitResource struct {
 
 type BenefitResourceModel struct {
 	ID                         types.String                            `tfsdk:"id"`
+	OrganizationID             types.String                            `tfsdk:"organization_id"`
 	Type                       types.String                            `tfsdk:"type"`
 	Description                types.String                            `tfsdk:"description"`
 	Metadata                   types.Map                               `tfsdk:"metadata"`
@@ -48,6 +53,13 @@ type BenefitResourceModel struct {
 	MeterCreditProperties      *BenefitMeterCreditPropertiesModel      `tfsdk:"meter_credit_properties"`
 }
 
+// benefitResourceData is the polar_benefit resource's state: BenefitResourceModel, which
+// the benefit data sources share, plus resource-only settings.
+type benefitResourceData struct {
+	BenefitResourceModel
+	Consistency *ConsistencyOverrideModel `tfsdk:"consistency"`
+}
+
 type BenefitCustomPropertiesModel struct {
 	Note types.String `tfsdk:"note"`
 }
@@ -110,6 +122,7 @@ func (r *BenefitResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"organization_id": organizationIDAttribute("benefit"),
 			"type": schema.StringAttribute{
 				MarkdownDescription: "The benefit type. Changing this forces a new resource. Must be one of: `custom`, `discord`, `github_repository`, `downloadables`, `license_keys`, `meter_credit`.",
 				Required:            true,
@@ -269,6 +282,7 @@ func (r *BenefitResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
+			"consistency": consistencyOverrideAttribute(),
 		},
 	}
 }
@@ -276,6 +290,9 @@ func (r *BenefitResource) Schema(ctx context.Context, req resource.SchemaRequest
 func (r *BenefitResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
 		r.client = pd.Client
+		r.consistency = pd.Consistency
+		r.retry = pd.Retry
+		r.organizationID = pd.OrganizationID
 	}
 }
 
@@ -290,7 +307,7 @@ var benefitPropertiesAttrs = map[string]string{
 }
 
 func (r *BenefitResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data BenefitResourceModel
+	var data benefitResourceData
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -337,20 +354,22 @@ func (r *BenefitResource) ValidateConfig(ctx context.Context, req resource.Valid
 // The benefit SDK types are polymorphic (union), so buildBenefitCreateRequest
 // dispatches to the correct builder based on the `type` field.
 func (r *BenefitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data BenefitResourceModel
+	ctx = withCorrelationID(ctx)
+	var data benefitResourceData
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Build the SDK request — dispatches by benefit type (custom, discord, etc.).
-	createReq, diags := buildBenefitCreateRequest(ctx, &data)
+	data.OrganizationID = resolveOrganizationID(data.OrganizationID, r.organizationID)
+	createReq, diags := buildBenefitCreateRequest(ctx, &data.BenefitResourceModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	result, err := r.client.Benefits.Create(ctx, *createReq)
+	result, err := r.client.Benefits.Create(ctx, *createReq, r.retry.createOption())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating benefit",
@@ -368,7 +387,7 @@ func (r *BenefitResource) Create(ctx context.Context, req resource.CreateRequest
 	// needed by pollForConsistency.
 	id := benefitID(*result.Benefit)
 	writeTime := latestTimestamp(&timestampedBenefit{result.Benefit})
-	wrappedBenefit, err := pollForConsistency(ctx, "benefit", id, writeTime, func() (*timestampedBenefit, error) {
+	wrappedBenefit, err := pollForConsistency(ctx, r.consistency.withOverride(data.Consistency), "benefit", id, writeTime, func() (*timestampedBenefit, error) {
 		result, err := r.client.Benefits.Get(ctx, id)
 		if err != nil {
 			return nil, err
@@ -383,12 +402,13 @@ func (r *BenefitResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	mapBenefitResponseToState(ctx, wrappedBenefit.Benefit, &data, &resp.Diagnostics)
+	mapBenefitResponseToState(ctx, wrappedBenefit.Benefit, &data.BenefitResourceModel, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *BenefitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data BenefitResourceModel
+	ctx = withCorrelationID(ctx)
+	var data benefitResourceData
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -406,18 +426,19 @@ func (r *BenefitResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	mapBenefitResponseToState(ctx, result.Benefit, &data, &resp.Diagnostics)
+	mapBenefitResponseToState(ctx, result.Benefit, &data.BenefitResourceModel, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *BenefitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data BenefitResourceModel
+	ctx = withCorrelationID(ctx)
+	var data benefitResourceData
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateReq, diags := buildBenefitUpdateRequest(ctx, &data)
+	updateReq, diags := buildBenefitUpdateRequest(ctx, &data.BenefitResourceModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -434,7 +455,7 @@ func (r *BenefitResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	benefitID := data.ID.ValueString()
 	writeTime := latestTimestamp(&timestampedBenefit{result.Benefit})
-	wrappedBenefit, err := pollForConsistency(ctx, "benefit", benefitID, writeTime, func() (*timestampedBenefit, error) {
+	wrappedBenefit, err := pollForConsistency(ctx, r.consistency.withOverride(data.Consistency), "benefit", benefitID, writeTime, func() (*timestampedBenefit, error) {
 		result, err := r.client.Benefits.Get(ctx, benefitID)
 		if err != nil {
 			return nil, err
@@ -449,13 +470,14 @@ func (r *BenefitResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	mapBenefitResponseToState(ctx, wrappedBenefit.Benefit, &data, &resp.Diagnostics)
+	mapBenefitResponseToState(ctx, wrappedBenefit.Benefit, &data.BenefitResourceModel, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Delete performs a real DELETE (unlike meters/products which archive).
 func (r *BenefitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data BenefitResourceModel
+	ctx = withCorrelationID(ctx)
+	var data benefitResourceData
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -478,6 +500,28 @@ func (r *BenefitResource) Delete(ctx context.Context, req resource.DeleteRequest
 	})
 }
 
+// ImportState accepts an ID, "name:<description>" or "metadata:<key>=<value>";
+// benefits have no name, so "name:" matches the description exactly. The
+// lookup is the polar_benefit data source's.
 func (r *BenefitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ctx = withCorrelationID(ctx)
+	importStateByLookup(ctx, req, resp, func(lookup objectLookup, diags *diag.Diagnostics) string {
+		data := BenefitDataSourceModel{MetadataFilter: types.MapNull(types.StringType)}
+		data.Type = types.StringNull()
+		data.Description = types.StringNull()
+		if lookup.Name != "" {
+			data.Description = types.StringValue(lookup.Name)
+		}
+		if filter := lookup.metadataFilter(); filter != nil {
+			var d diag.Diagnostics
+			data.MetadataFilter, d = types.MapValueFrom(ctx, types.StringType, filter)
+			diags.Append(d...)
+		}
+		data.OrganizationID = resolveOrganizationID(types.StringNull(), r.organizationID)
+
+		if b := findBenefit(ctx, r.client, &data, diags); b != nil {
+			return benefitID(*b)
+		}
+		return ""
+	})
 }
@@ -90,8 +90,9 @@ func buildCustomCreate(ctx context.Context, description string, data *BenefitRes
 		props.Note = &note
 	}
 	create := components.BenefitCustomCreate{
-		Description: description,
-		Properties:  props,
+		Description:    description,
+		OrganizationID: optionalStringPointer(data.OrganizationID),
+		Properties:     props,
 	}
 	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
 		m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateBenefitCustomCreateMetadataStr)
@@ -111,7 +112,8 @@ func buildDiscordCreate(ctx context.Context, description string, data *BenefitRe
 		return nil, *diags
 	}
 	create := components.BenefitDiscordCreate{
-		Description: description,
+		Description:    description,
+		OrganizationID: optionalStringPointer(data.OrganizationID),
 		Properties: components.BenefitDiscordCreateProperties{
 			GuildToken: data.DiscordProperties.GuildToken.ValueString(),
 			RoleID:     data.DiscordProperties.RoleID.ValueString(),
@@ -136,7 +138,8 @@ func buildGitHubRepositoryCreate(ctx context.Context, description string, data *
 		return nil, *diags
 	}
 	create := components.BenefitGitHubRepositoryCreate{
-		Description: description,
+		Description:    description,
+		OrganizationID: optionalStringPointer(data.OrganizationID),
 		Properties: components.BenefitGitHubRepositoryCreateProperties{
 			RepositoryOwner: data.GitHubRepositoryProperties.RepositoryOwner.ValueString(),
 			RepositoryName:  data.GitHubRepositoryProperties.RepositoryName.ValueString(),
@@ -167,7 +170,8 @@ func buildDownloadablesCreate(ctx context.Context, description string, data *Ben
 		return nil, *diags
 	}
 	create := components.BenefitDownloadablesCreate{
-		Description: description,
+		Description:    description,
+		OrganizationID: optionalStringPointer(data.OrganizationID),
 		Properties: components.BenefitDownloadablesCreateProperties{
 			Files: files,
 		},
@@ -191,8 +195,9 @@ func buildLicenseKeysCreate(ctx context.Context, description string, data *Benef
 	}
 	props := licenseKeysPropsToSDK(data.LicenseKeysProperties)
 	create := components.BenefitLicenseKeysCreate{
-		Description: description,
-		Properties:  props,
+		Description:    description,
+		OrganizationID: optionalStringPointer(data.OrganizationID),
+		Properties:     props,
 	}
 	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
 		m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateBenefitLicenseKeysCreateMetadataStr)
@@ -212,7 +217,8 @@ func buildMeterCreditCreate(ctx context.Context, description string, data *Benef
 		return nil, *diags
 	}
 	create := components.BenefitMeterCreditCreate{
-		Description: description,
+		Description:    description,
+		OrganizationID: optionalStringPointer(data.OrganizationID),
 		Properties: components.BenefitMeterCreditCreateProperties{
 			MeterID:  data.MeterCreditProperties.MeterID.ValueString(),
 			Units:    data.MeterCreditProperties.Units.ValueInt64(),
@@ -385,7 +391,7 @@ func mapBenefitResponseToState(ctx context.Context, benefit *components.Benefit,
 	switch {
 	case benefit.BenefitCustom != nil:
 		b := benefit.BenefitCustom
-		setBenefitCommonFields(b.ID, "custom", b.Description, data)
+		setBenefitCommonFields(b.ID, b.OrganizationID, "custom", b.Description, data)
 		data.CustomProperties = &BenefitCustomPropertiesModel{
 			Note: optionalStringValue(b.Properties.Note),
 		}
@@ -395,7 +401,7 @@ func mapBenefitResponseToState(ctx context.Context, benefit *components.Benefit,
 
 	case benefit.BenefitDiscord != nil:
 		b := benefit.BenefitDiscord
-		setBenefitCommonFields(b.ID, "discord", b.Description, data)
+		setBenefitCommonFields(b.ID, b.OrganizationID, "discord", b.Description, data)
 		data.DiscordProperties = &BenefitDiscordPropertiesModel{
 			GuildToken: types.StringValue(b.Properties.GuildToken),
 			RoleID:     types.StringValue(b.Properties.RoleID),
@@ -408,7 +414,7 @@ func mapBenefitResponseToState(ctx context.Context, benefit *components.Benefit,
 
 	case benefit.BenefitGitHubRepository != nil:
 		b := benefit.BenefitGitHubRepository
-		setBenefitCommonFields(b.ID, "github_repository", b.Description, data)
+		setBenefitCommonFields(b.ID, b.OrganizationID, "github_repository", b.Description, data)
 		data.GitHubRepositoryProperties = &BenefitGitHubRepositoryPropertiesModel{
 			RepositoryOwner: types.StringValue(b.Properties.RepositoryOwner),
 			RepositoryName:  types.StringValue(b.Properties.RepositoryName),
@@ -420,7 +426,7 @@ func mapBenefitResponseToState(ctx context.Context, benefit *components.Benefit,
 
 	case benefit.BenefitDownloadables != nil:
 		b := benefit.BenefitDownloadables
-		setBenefitCommonFields(b.ID, "downloadables", b.Description, data)
+		setBenefitCommonFields(b.ID, b.OrganizationID, "downloadables", b.Description, data)
 		filesList, d := types.ListValueFrom(ctx, types.StringType, b.Properties.Files)
 		diags.Append(d...)
 		data.DownloadablesProperties = &BenefitDownloadablesPropertiesModel{
@@ -432,7 +438,7 @@ func mapBenefitResponseToState(ctx context.Context, benefit *components.Benefit,
 
 	case benefit.BenefitLicenseKeys != nil:
 		b := benefit.BenefitLicenseKeys
-		setBenefitCommonFields(b.ID, "license_keys", b.Description, data)
+		setBenefitCommonFields(b.ID, b.OrganizationID, "license_keys", b.Description, data)
 		data.LicenseKeysProperties = sdkLicenseKeysPropsToModel(&b.Properties)
 		data.Metadata = sdkMetadataToMap(ctx, b.Metadata, func(v components.BenefitLicenseKeysMetadata) metadataFields {
 			return metadataFields{Str: v.Str, Integer: v.Integer, Number: v.Number, Boolean: v.Boolean}
@@ -440,7 +446,7 @@ func mapBenefitResponseToState(ctx context.Context, benefit *components.Benefit,
 
 	case benefit.BenefitMeterCredit != nil:
 		b := benefit.BenefitMeterCredit
-		setBenefitCommonFields(b.ID, "meter_credit", b.Description, data)
+		setBenefitCommonFields(b.ID, b.OrganizationID, "meter_credit", b.Description, data)
 		data.MeterCreditProperties = &BenefitMeterCreditPropertiesModel{
 			MeterID:  types.StringValue(b.Properties.MeterID),
 			Units:    types.Int64Value(b.Properties.Units),
@@ -457,8 +463,9 @@ func mapBenefitResponseToState(ctx context.Context, benefit *components.Benefit,
 
 // --- Shared helpers ---
 
-func setBenefitCommonFields(id, benefitType, description string, data *BenefitResourceModel) {
+func setBenefitCommonFields(id, organizationID, benefitType, description string, data *BenefitResourceModel) {
 	data.ID = types.StringValue(id)
+	data.OrganizationID = types.StringValue(organizationID)
 	data.Type = types.StringValue(benefitType)
 	data.Description = types.StringValue(description)
 }
@@ -239,6 +239,42 @@ resource "polar_benefit" "test" {
 	})
 }
 
+// TestBenefitResource_offline runs the benefit lifecycle against the
+// polartest fake.
+func TestBenefitResource_offline(t *testing.T) {
+	_, factories := testFakeProviderFactories(t)
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProviderConfig + testAccBenefitLicenseKeysConfig("Offline keys", "OFF", 3),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_benefit.test",
+						tfjsonpath.New("license_keys_properties").AtMapKey("prefix"),
+						knownvalue.StringExact("OFF"),
+					),
+				},
+			},
+			{
+				ResourceName:      "polar_benefit.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testFakeProviderConfig + testAccBenefitLicenseKeysConfig("Offline keys", "UPD", 5),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_benefit.test",
+						tfjsonpath.New("license_keys_properties").AtMapKey("limit_usage"),
+						knownvalue.Int64Exact(5),
+					),
+				},
+			},
+		},
+	})
+}
+
 // --- Config helpers ---
 
 func testAccBenefitCustomConfig(description, note string) string {
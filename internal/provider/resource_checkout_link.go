@@ -0,0 +1,316 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/polarsource/polar-go"
+	"github.com/polarsource/polar-go/models/components"
+)
+
+// Compile-time interface conformance checks.
+var _ resource.Resource = &CheckoutLinkResource{}
+var _ resource.ResourceWithImportState = &CheckoutLinkResource{}
+var _ resource.ResourceWithValidateConfig = &CheckoutLinkResource{}
+
+func NewCheckoutLinkResource() resource.Resource {
+	return &CheckoutLinkResource{}
+}
+
+type CheckoutLinkResource struct {
+	client       *polargo.Polar
+	supplemental *supplementalClient // clears fields CheckoutLinkUpdate can't unset
+	consistency  consistencyPolicy   // provider consistency settings, see pollForConsistency
+	retry        retryPolicy         // provider retry settings, see retryPolicy.createOption
+}
+
+// CheckoutLinkResourceModel is the Terraform state shape for polar_checkout_link.
+type CheckoutLinkResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	ProductIDs            types.Set    `tfsdk:"product_ids"`
+	Label                 types.String `tfsdk:"label"`
+	SuccessURL            types.String `tfsdk:"success_url"`
+	AllowDiscountCodes    types.Bool   `tfsdk:"allow_discount_codes"`
+	RequireBillingAddress types.Bool   `tfsdk:"require_billing_address"`
+	DiscountID            types.String `tfsdk:"discount_id"`
+	Metadata              types.Map    `tfsdk:"metadata"`
+	URL                   types.String `tfsdk:"url"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+
+	Consistency *ConsistencyOverrideModel `tfsdk:"consistency"`
+}
+
+func (r *CheckoutLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_checkout_link"
+}
+
+func (r *CheckoutLinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Polar checkout link. Checkout links are stable, shareable URLs that open a hosted checkout session for one or more products.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The checkout link ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"product_ids": schema.SetAttribute{
+				MarkdownDescription: "Set of product IDs available to select at checkout. The checkout form collects the custom fields attached to each product via `attached_custom_fields` on `polar_product`.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Optional label to distinguish links internally. Not shown to customers.",
+				Optional:            true,
+			},
+			"success_url": schema.StringAttribute{
+				MarkdownDescription: "URL where the customer is redirected after a successful payment. Add the `checkout_id={CHECKOUT_ID}` query parameter to receive the checkout session ID.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^https?://`),
+						"success URL must be an absolute http(s) URL",
+					),
+				},
+			},
+			"allow_discount_codes": schema.BoolAttribute{
+				MarkdownDescription: "Whether customers can apply discount codes at checkout. A discount set via `discount_id` is still applied when this is `false`, but the customer can't change it. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"require_billing_address": schema.BoolAttribute{
+				MarkdownDescription: "Whether customers must enter their full billing address instead of just the country. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"discount_id": schema.StringAttribute{
+				MarkdownDescription: "ID of a discount to apply automatically. Ignored at checkout if the discount is no longer applicable.",
+				Optional:            true,
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Key-value metadata.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The public URL of the checkout link.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The client secret embedded in the checkout link URL.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"consistency": consistencyOverrideAttribute(),
+		},
+	}
+}
+
+func (r *CheckoutLinkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CheckoutLinkResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateMetadata(ctx, data.Metadata, &resp.Diagnostics)
+}
+
+func (r *CheckoutLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
+		r.client = pd.Client
+		r.supplemental = pd.Supplemental
+		r.consistency = pd.Consistency
+		r.retry = pd.Retry
+	}
+}
+
+func (r *CheckoutLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withCorrelationID(ctx)
+	var data CheckoutLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq, diags := buildCheckoutLinkCreateRequest(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CheckoutLinks.Create(ctx, *createReq, r.retry.createOption())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating checkout link",
+			fmt.Sprintf("Could not create checkout link: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created checkout link", map[string]interface{}{
+		"id": result.CheckoutLink.ID,
+	})
+
+	// Poll until the link is readable so the URL is safe to hand out
+	// (e.g. via outputs) as soon as apply finishes.
+	linkID := result.CheckoutLink.ID
+	writeTime := latestTimestamp(result.CheckoutLink)
+	link, err := pollForConsistency(ctx, r.consistency.withOverride(data.Consistency), "checkout link", linkID, writeTime, func() (*components.CheckoutLink, error) {
+		result, err := r.client.CheckoutLinks.Get(ctx, linkID)
+		if err != nil {
+			return nil, err
+		}
+		return result.CheckoutLink, nil
+	}, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for checkout link visibility",
+			fmt.Sprintf("Checkout link %s was created but not immediately readable: %s", linkID, err),
+		)
+		return
+	}
+
+	mapCheckoutLinkResponseToState(ctx, link, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes TF state from the API. Deleted links (404) are removed from state.
+func (r *CheckoutLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = withCorrelationID(ctx)
+	var data CheckoutLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CheckoutLinks.Get(ctx, data.ID.ValueString())
+	if err != nil {
+		if handleNotFoundRemove(ctx, err, "checkout link", data.ID.ValueString(), &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading checkout link",
+			fmt.Sprintf("Could not read checkout link %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	mapCheckoutLinkResponseToState(ctx, result.CheckoutLink, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update: plan → build SDK request → call API → poll for consistency → save state.
+func (r *CheckoutLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = withCorrelationID(ctx)
+	var data, prior CheckoutLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := buildCheckoutLinkUpdateRequest(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linkID := data.ID.ValueString()
+
+	clearPayload := buildCheckoutLinkClearPayload(&data, &prior)
+	if err := patchClearedFields(ctx, r.supplemental, "/v1/checkout-links/"+url.PathEscape(linkID), clearPayload); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating checkout link",
+			fmt.Sprintf("Could not clear removed fields on checkout link %s: %s", linkID, err),
+		)
+		return
+	}
+	result, err := r.client.CheckoutLinks.Update(ctx, linkID, *updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating checkout link",
+			fmt.Sprintf("Could not update checkout link %s: %s", linkID, err),
+		)
+		return
+	}
+
+	writeTime := latestTimestamp(result.CheckoutLink)
+	link, err := pollForConsistency(ctx, r.consistency.withOverride(data.Consistency), "checkout link", linkID, writeTime, func() (*components.CheckoutLink, error) {
+		result, err := r.client.CheckoutLinks.Get(ctx, linkID)
+		if err != nil {
+			return nil, err
+		}
+		return result.CheckoutLink, nil
+	}, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading checkout link after update",
+			fmt.Sprintf("Could not read checkout link %s: %s", linkID, err),
+		)
+		return
+	}
+
+	mapCheckoutLinkResponseToState(ctx, link, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete performs a real DELETE. The link URL stops working immediately;
+// checkouts already in progress are unaffected.
+func (r *CheckoutLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withCorrelationID(ctx)
+	var data CheckoutLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.CheckoutLinks.Delete(ctx, data.ID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting checkout link",
+			fmt.Sprintf("Could not delete checkout link %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted checkout link", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *CheckoutLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/polarsource/polar-go/models/components"
+)
+
+// --- Build SDK Create request ---
+// The SDK offers three create variants (single price, single product, product
+// list). We always use the product-list variant since product_ids is a set.
+
+func buildCheckoutLinkCreateRequest(ctx context.Context, data *CheckoutLinkResourceModel) (*components.CheckoutLinkCreate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var products []string
+	diags.Append(data.ProductIDs.ElementsAs(ctx, &products, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	allowDiscountCodes := data.AllowDiscountCodes.ValueBool()
+	requireBillingAddress := data.RequireBillingAddress.ValueBool()
+	createReq := components.CheckoutLinkCreateProducts{
+		Products:              products,
+		Label:                 optionalStringPointer(data.Label),
+		SuccessURL:            optionalStringPointer(data.SuccessURL),
+		DiscountID:            optionalStringPointer(data.DiscountID),
+		AllowDiscountCodes:    &allowDiscountCodes,
+		RequireBillingAddress: &requireBillingAddress,
+	}
+
+	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+		m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateCheckoutLinkCreateProductsMetadataStr)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		createReq.Metadata = m
+	}
+
+	result := components.CreateCheckoutLinkCreateCheckoutLinkCreateProducts(createReq)
+	return &result, diags
+}
+
+// --- Build SDK Update request ---
+
+func buildCheckoutLinkUpdateRequest(ctx context.Context, data *CheckoutLinkResourceModel) (*components.CheckoutLinkUpdate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var products []string
+	diags.Append(data.ProductIDs.ElementsAs(ctx, &products, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	allowDiscountCodes := data.AllowDiscountCodes.ValueBool()
+	requireBillingAddress := data.RequireBillingAddress.ValueBool()
+	update := components.CheckoutLinkUpdate{
+		Products:              products,
+		Label:                 optionalStringPointer(data.Label),
+		SuccessURL:            optionalStringPointer(data.SuccessURL),
+		DiscountID:            optionalStringPointer(data.DiscountID),
+		AllowDiscountCodes:    &allowDiscountCodes,
+		RequireBillingAddress: &requireBillingAddress,
+	}
+
+	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+		m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateCheckoutLinkUpdateMetadataStr)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		update.Metadata = m
+	}
+
+	return &update, diags
+}
+
+// buildCheckoutLinkClearPayload returns the fields set in prior state that the
+// plan removes or empties, as the explicit null or empty value that clears
+// them. CheckoutLinkUpdate can't carry these (see clearRemovedField), so
+// Update sends them through the supplemental client.
+func buildCheckoutLinkClearPayload(data, prior *CheckoutLinkResourceModel) map[string]any {
+	payload := map[string]any{}
+	clearRemovedField(payload, "label", data.Label, prior.Label, nil)
+	clearRemovedField(payload, "success_url", data.SuccessURL, prior.SuccessURL, nil)
+	clearRemovedField(payload, "discount_id", data.DiscountID, prior.DiscountID, nil)
+	clearRemovedField(payload, "metadata", data.Metadata, prior.Metadata, map[string]any{})
+	return payload
+}
+
+// --- Map SDK response to Terraform state ---
+
+func mapCheckoutLinkResponseToState(ctx context.Context, link *components.CheckoutLink, data *CheckoutLinkResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(link.ID)
+	data.Label = optionalStringValue(link.Label)
+	data.SuccessURL = optionalStringValue(link.SuccessURL)
+	data.AllowDiscountCodes = types.BoolValue(link.AllowDiscountCodes)
+	data.RequireBillingAddress = types.BoolValue(link.RequireBillingAddress)
+	data.DiscountID = optionalStringValue(link.DiscountID)
+	data.URL = types.StringValue(link.URL)
+	data.ClientSecret = types.StringValue(link.ClientSecret)
+
+	data.Metadata = sdkMetadataToMap(ctx, link.Metadata, func(v components.CheckoutLinkMetadata) metadataFields {
+		return metadataFields{Str: v.Str, Integer: v.Integer, Number: v.Number, Boolean: v.Boolean}
+	}, diags)
+
+	ids := make([]string, len(link.Products))
+	for i, p := range link.Products {
+		ids[i] = p.ID
+	}
+	productSet, d := types.SetValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	data.ProductIDs = productSet
+}
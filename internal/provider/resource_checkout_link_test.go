@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/polarsource/polar-go/models/components"
+)
+
+func TestAccCheckoutLinkResource_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccCheckoutLinkConfig(rName, "launch", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_checkout_link.test",
+						tfjsonpath.New("label"),
+						knownvalue.StringExact("launch"),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_checkout_link.test",
+						tfjsonpath.New("product_ids"),
+						knownvalue.SetSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_checkout_link.test",
+						tfjsonpath.New("allow_discount_codes"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_checkout_link.test",
+						tfjsonpath.New("url"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// ImportState
+			{
+				ResourceName:      "polar_checkout_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update label and discount allowance in place
+			{
+				Config: testAccCheckoutLinkConfig(rName, "launch-updated", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_checkout_link.test",
+						tfjsonpath.New("label"),
+						knownvalue.StringExact("launch-updated"),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_checkout_link.test",
+						tfjsonpath.New("allow_discount_codes"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			// Remove label and success_url
+			{
+				Config: testAccCheckoutLinkMinimalConfig(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_checkout_link.test",
+						tfjsonpath.New("label"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_checkout_link.test",
+						tfjsonpath.New("success_url"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestMapCheckoutLinkResponseToState(t *testing.T) {
+	ctx := context.Background()
+	label := "launch"
+	link := &components.CheckoutLink{
+		ID:                 "cl_123",
+		ClientSecret:       "polar_cl_secret",
+		URL:                "https://buy.polar.sh/polar_cl_secret",
+		Label:              &label,
+		AllowDiscountCodes: true,
+		Products: []components.CheckoutLinkProduct{
+			{ID: "prod_1"},
+			{ID: "prod_2"},
+		},
+	}
+
+	var data CheckoutLinkResourceModel
+	var diags diag.Diagnostics
+	mapCheckoutLinkResponseToState(ctx, link, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := data.URL.ValueString(); got != link.URL {
+		t.Errorf("url = %q, want %q", got, link.URL)
+	}
+	if got := data.Label.ValueString(); got != label {
+		t.Errorf("label = %q, want %q", got, label)
+	}
+	if !data.SuccessURL.IsNull() {
+		t.Errorf("success_url = %s, want null", data.SuccessURL)
+	}
+	if !data.DiscountID.IsNull() {
+		t.Errorf("discount_id = %s, want null", data.DiscountID)
+	}
+	if got := len(data.ProductIDs.Elements()); got != 2 {
+		t.Errorf("product_ids has %d elements, want 2", got)
+	}
+	// Metadata is always a non-null map so Optional+Computed doesn't oscillate.
+	if data.Metadata.IsNull() {
+		t.Error("metadata should be an empty map, got null")
+	}
+}
+
+func TestBuildCheckoutLinkClearPayload(t *testing.T) {
+	prior := CheckoutLinkResourceModel{
+		Label:      types.StringValue("launch"),
+		SuccessURL: types.StringValue("https://example.com/success"),
+		DiscountID: types.StringValue("disc_1"),
+		Metadata:   types.MapValueMust(types.StringType, map[string]attr.Value{"tier": types.StringValue("pro")}),
+	}
+
+	plan := prior
+	if got := buildCheckoutLinkClearPayload(&plan, &prior); len(got) != 0 {
+		t.Errorf("unchanged plan: payload = %#v, want empty", got)
+	}
+
+	plan = CheckoutLinkResourceModel{
+		Label:      types.StringNull(),
+		SuccessURL: types.StringNull(),
+		DiscountID: types.StringNull(),
+		Metadata:   types.MapValueMust(types.StringType, map[string]attr.Value{}),
+	}
+	want := map[string]any{
+		"label":       nil,
+		"success_url": nil,
+		"discount_id": nil,
+		"metadata":    map[string]any{},
+	}
+	if got := buildCheckoutLinkClearPayload(&plan, &prior); !reflect.DeepEqual(got, want) {
+		t.Errorf("removed fields: payload = %#v, want %#v", got, want)
+	}
+}
+
+// --- Config helpers ---
+
+func testAccCheckoutLinkConfig(name, label string, allowDiscountCodes bool) string {
+	return fmt.Sprintf(`
+resource "polar_product" "test" {
+  name = %[1]q
+
+  prices = [{
+    amount_type  = "fixed"
+    price_amount = 1000
+  }]
+}
+
+resource "polar_checkout_link" "test" {
+  product_ids          = [polar_product.test.id]
+  label                = %[2]q
+  success_url          = "https://example.com/success?checkout_id={CHECKOUT_ID}"
+  allow_discount_codes = %[3]t
+}
+`, name, label, allowDiscountCodes)
+}
+
+func testAccCheckoutLinkMinimalConfig(name string) string {
+	return fmt.Sprintf(`
+resource "polar_product" "test" {
+  name = %q
+
+  prices = [{
+    amount_type  = "fixed"
+    price_amount = 1000
+  }]
+}
+
+resource "polar_checkout_link" "test" {
+  product_ids = [polar_product.test.id]
+}
+`, name)
+}
@@ -0,0 +1,466 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/polarsource/polar-go"
+)
+
+// Compile-time interface conformance checks.
+var _ resource.Resource = &CustomFieldResource{}
+var _ resource.ResourceWithImportState = &CustomFieldResource{}
+var _ resource.ResourceWithValidateConfig = &CustomFieldResource{}
+
+func NewCustomFieldResource() resource.Resource {
+	return &CustomFieldResource{}
+}
+
+type CustomFieldResource struct {
+	client         *polargo.Polar
+	organizationID string            // provider default, see resolveOrganizationID
+	consistency    consistencyPolicy // provider consistency settings, see pollForConsistency
+	retry          retryPolicy       // provider retry settings, see retryPolicy.createOption
+}
+
+// --- Terraform model types ---
+// Custom fields are a polymorphic resource — the `type` field determines which
+// `*_properties` block is relevant. Same shape as BenefitResourceModel.
+
+type CustomFieldResourceModel struct {
+	ID                 types.String                        `tfsdk:"id"`
+	OrganizationID     types.String                        `tfsdk:"organization_id"`
+	Type               types.String                        `tfsdk:"type"`
+	Slug               types.String                        `tfsdk:"slug"`
+	Name               types.String                        `tfsdk:"name"`
+	Metadata           types.Map                           `tfsdk:"metadata"`
+	TextProperties     *CustomFieldTextPropertiesModel     `tfsdk:"text_properties"`
+	NumberProperties   *CustomFieldRangePropertiesModel    `tfsdk:"number_properties"`
+	DateProperties     *CustomFieldRangePropertiesModel    `tfsdk:"date_properties"`
+	CheckboxProperties *CustomFieldCheckboxPropertiesModel `tfsdk:"checkbox_properties"`
+	SelectProperties   *CustomFieldSelectPropertiesModel   `tfsdk:"select_properties"`
+
+	Consistency *ConsistencyOverrideModel `tfsdk:"consistency"`
+}
+
+type CustomFieldTextPropertiesModel struct {
+	FormLabel       types.String `tfsdk:"form_label"`
+	FormHelpText    types.String `tfsdk:"form_help_text"`
+	FormPlaceholder types.String `tfsdk:"form_placeholder"`
+	Textarea        types.Bool   `tfsdk:"textarea"`
+	MinLength       types.Int64  `tfsdk:"min_length"`
+	MaxLength       types.Int64  `tfsdk:"max_length"`
+}
+
+// CustomFieldRangePropertiesModel is shared by number and date fields, which
+// expose identical properties. For dates, ge/le are Unix timestamps.
+type CustomFieldRangePropertiesModel struct {
+	FormLabel       types.String `tfsdk:"form_label"`
+	FormHelpText    types.String `tfsdk:"form_help_text"`
+	FormPlaceholder types.String `tfsdk:"form_placeholder"`
+	Ge              types.Int64  `tfsdk:"ge"`
+	Le              types.Int64  `tfsdk:"le"`
+}
+
+type CustomFieldCheckboxPropertiesModel struct {
+	FormLabel       types.String `tfsdk:"form_label"`
+	FormHelpText    types.String `tfsdk:"form_help_text"`
+	FormPlaceholder types.String `tfsdk:"form_placeholder"`
+}
+
+type CustomFieldSelectPropertiesModel struct {
+	FormLabel       types.String                   `tfsdk:"form_label"`
+	FormHelpText    types.String                   `tfsdk:"form_help_text"`
+	FormPlaceholder types.String                   `tfsdk:"form_placeholder"`
+	Options         []CustomFieldSelectOptionModel `tfsdk:"options"`
+}
+
+type CustomFieldSelectOptionModel struct {
+	Value types.String `tfsdk:"value"`
+	Label types.String `tfsdk:"label"`
+}
+
+// --- Resource interface ---
+
+func (r *CustomFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_field"
+}
+
+// customFieldFormAttributes returns the form display attributes shared by all
+// custom field types, merged with the type-specific attributes in extra.
+func customFieldFormAttributes(extra map[string]schema.Attribute) map[string]schema.Attribute {
+	attrs := map[string]schema.Attribute{
+		"form_label": schema.StringAttribute{
+			MarkdownDescription: "Label displayed above the field at checkout. Defaults to the field name.",
+			Optional:            true,
+		},
+		"form_help_text": schema.StringAttribute{
+			MarkdownDescription: "Help text displayed below the field. Supports Markdown.",
+			Optional:            true,
+		},
+		"form_placeholder": schema.StringAttribute{
+			MarkdownDescription: "Placeholder displayed when the field is empty.",
+			Optional:            true,
+		},
+	}
+	for k, v := range extra {
+		attrs[k] = v
+	}
+	return attrs
+}
+
+func (r *CustomFieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	rangeAttributes := func(unit string) map[string]schema.Attribute {
+		return customFieldFormAttributes(map[string]schema.Attribute{
+			"ge": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Minimum accepted value (inclusive), as %s.", unit),
+				Optional:            true,
+			},
+			"le": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum accepted value (inclusive), as %s.", unit),
+				Optional:            true,
+			},
+		})
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Polar custom field. Custom fields collect additional information from customers at checkout, such as a VAT number or company size. Attach them to products with the `attached_custom_fields` attribute of `polar_product`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The custom field ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": organizationIDAttribute("custom field"),
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The custom field type. Changing this forces a new resource. Must be one of: `text`, `number`, `date`, `checkbox`, `select`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("text", "number", "date", "checkbox", "select"),
+				},
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the custom field, used as the key when storing values. Must be unique across the organization and contain only ASCII letters, numbers, and hyphens.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9-]+$`),
+						"slug can only contain ASCII letters, numbers, and hyphens",
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the custom field.",
+				Required:            true,
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Key-value metadata.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+
+			// Type-specific properties (at most one should match the type)
+			"text_properties": schema.SingleNestedAttribute{
+				MarkdownDescription: "Properties for `text` type custom fields.",
+				Optional:            true,
+				Attributes: customFieldFormAttributes(map[string]schema.Attribute{
+					"textarea": schema.BoolAttribute{
+						MarkdownDescription: "Whether to render a multi-line text area instead of a single-line input.",
+						Optional:            true,
+					},
+					"min_length": schema.Int64Attribute{
+						MarkdownDescription: "Minimum length of the value.",
+						Optional:            true,
+					},
+					"max_length": schema.Int64Attribute{
+						MarkdownDescription: "Maximum length of the value.",
+						Optional:            true,
+					},
+				}),
+			},
+			"number_properties": schema.SingleNestedAttribute{
+				MarkdownDescription: "Properties for `number` type custom fields.",
+				Optional:            true,
+				Attributes:          rangeAttributes("a number"),
+			},
+			"date_properties": schema.SingleNestedAttribute{
+				MarkdownDescription: "Properties for `date` type custom fields.",
+				Optional:            true,
+				Attributes:          rangeAttributes("a Unix timestamp"),
+			},
+			"checkbox_properties": schema.SingleNestedAttribute{
+				MarkdownDescription: "Properties for `checkbox` type custom fields.",
+				Optional:            true,
+				Attributes:          customFieldFormAttributes(nil),
+			},
+			"select_properties": schema.SingleNestedAttribute{
+				MarkdownDescription: "Properties for `select` type custom fields. Required when `type` is `select`.",
+				Optional:            true,
+				Attributes: customFieldFormAttributes(map[string]schema.Attribute{
+					"options": schema.ListNestedAttribute{
+						MarkdownDescription: "The options customers can choose from, in display order.",
+						Required:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"value": schema.StringAttribute{
+									MarkdownDescription: "The value stored when this option is selected.",
+									Required:            true,
+								},
+								"label": schema.StringAttribute{
+									MarkdownDescription: "The label displayed to the customer.",
+									Required:            true,
+								},
+							},
+						},
+					},
+				}),
+			},
+			"consistency": consistencyOverrideAttribute(),
+		},
+	}
+}
+
+func (r *CustomFieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
+		r.client = pd.Client
+		r.consistency = pd.Consistency
+		r.retry = pd.Retry
+		r.organizationID = pd.OrganizationID
+	}
+}
+
+// customFieldPropertiesAttrs maps each custom field type to its expected properties attribute name.
+var customFieldPropertiesAttrs = map[string]string{
+	"text":     "text_properties",
+	"number":   "number_properties",
+	"date":     "date_properties",
+	"checkbox": "checkbox_properties",
+	"select":   "select_properties",
+}
+
+func (r *CustomFieldResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CustomFieldResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateMetadata(ctx, data.Metadata, &resp.Diagnostics)
+
+	// type may be unknown during plan with variables — skip validation.
+	if data.Type.IsUnknown() {
+		return
+	}
+
+	fieldType := data.Type.ValueString()
+	expectedAttr, ok := customFieldPropertiesAttrs[fieldType]
+	if !ok {
+		return // OneOf validator on the type field handles invalid types
+	}
+
+	// Select fields are meaningless without options.
+	if fieldType == "select" && data.SelectProperties == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("select_properties"),
+			"Missing properties block",
+			"select_properties is required when type is \"select\".",
+		)
+	}
+
+	setBlocks := map[string]bool{
+		"text_properties":     data.TextProperties != nil,
+		"number_properties":   data.NumberProperties != nil,
+		"date_properties":     data.DateProperties != nil,
+		"checkbox_properties": data.CheckboxProperties != nil,
+		"select_properties":   data.SelectProperties != nil,
+	}
+
+	for attr, isSet := range setBlocks {
+		if attr == expectedAttr {
+			continue
+		}
+		if isSet {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Conflicting properties block",
+				fmt.Sprintf("%q cannot be set when type is %q. Use %q instead.", attr, fieldType, expectedAttr),
+			)
+		}
+	}
+}
+
+// Create: plan → build type-specific SDK request → call API → poll → save state.
+func (r *CustomFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withCorrelationID(ctx)
+	var data CustomFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrganizationID = resolveOrganizationID(data.OrganizationID, r.organizationID)
+	createReq, diags := buildCustomFieldCreateRequest(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CustomFields.Create(ctx, *createReq, r.retry.createOption())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating custom field",
+			fmt.Sprintf("Could not create custom field: %s", err),
+		)
+		return
+	}
+
+	// Custom field SDK response is a union — timestampedCustomField adapts it for polling.
+	created := &timestampedCustomField{result.CustomField}
+	id := created.id()
+
+	tflog.Trace(ctx, "created custom field", map[string]interface{}{
+		"id":   id,
+		"type": data.Type.ValueString(),
+	})
+
+	writeTime := latestTimestamp(created)
+	field, err := pollForConsistency(ctx, r.consistency.withOverride(data.Consistency), "custom field", id, writeTime, func() (*timestampedCustomField, error) {
+		result, err := r.client.CustomFields.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &timestampedCustomField{result.CustomField}, nil
+	}, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for custom field visibility",
+			fmt.Sprintf("Custom field %s was created but not immediately readable: %s", id, err),
+		)
+		return
+	}
+
+	mapCustomFieldResponseToState(ctx, field.CustomField, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = withCorrelationID(ctx)
+	var data CustomFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CustomFields.Get(ctx, data.ID.ValueString())
+	if err != nil {
+		if handleNotFoundRemove(ctx, err, "custom field", data.ID.ValueString(), &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading custom field",
+			fmt.Sprintf("Could not read custom field %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	mapCustomFieldResponseToState(ctx, result.CustomField, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = withCorrelationID(ctx)
+	var data CustomFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := buildCustomFieldUpdateRequest(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CustomFields.Update(ctx, data.ID.ValueString(), *updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating custom field",
+			fmt.Sprintf("Could not update custom field %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	fieldID := data.ID.ValueString()
+	writeTime := latestTimestamp(&timestampedCustomField{result.CustomField})
+	field, err := pollForConsistency(ctx, r.consistency.withOverride(data.Consistency), "custom field", fieldID, writeTime, func() (*timestampedCustomField, error) {
+		result, err := r.client.CustomFields.Get(ctx, fieldID)
+		if err != nil {
+			return nil, err
+		}
+		return &timestampedCustomField{result.CustomField}, nil
+	}, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading custom field after update",
+			fmt.Sprintf("Could not read custom field %s: %s", fieldID, err),
+		)
+		return
+	}
+
+	mapCustomFieldResponseToState(ctx, field.CustomField, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete performs a real DELETE. Values already collected at checkout are
+// kept on the Polar side but the field is detached from all products.
+func (r *CustomFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withCorrelationID(ctx)
+	var data CustomFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.CustomFields.Delete(ctx, data.ID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting custom field",
+			fmt.Sprintf("Could not delete custom field %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted custom field", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *CustomFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
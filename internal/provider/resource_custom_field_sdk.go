@@ -0,0 +1,402 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/polarsource/polar-go/models/components"
+)
+
+// timestampedCustomField wraps *components.CustomField (a union type) to
+// satisfy the Timestamped interface. Same adapter pattern as timestampedBenefit.
+type timestampedCustomField struct{ *components.CustomField }
+
+func (f *timestampedCustomField) GetCreatedAt() time.Time {
+	switch {
+	case f.CustomFieldText != nil:
+		return f.CustomFieldText.GetCreatedAt()
+	case f.CustomFieldNumber != nil:
+		return f.CustomFieldNumber.GetCreatedAt()
+	case f.CustomFieldDate != nil:
+		return f.CustomFieldDate.GetCreatedAt()
+	case f.CustomFieldCheckbox != nil:
+		return f.CustomFieldCheckbox.GetCreatedAt()
+	case f.CustomFieldSelect != nil:
+		return f.CustomFieldSelect.GetCreatedAt()
+	}
+	return time.Time{}
+}
+
+func (f *timestampedCustomField) GetModifiedAt() *time.Time {
+	switch {
+	case f.CustomFieldText != nil:
+		return f.CustomFieldText.GetModifiedAt()
+	case f.CustomFieldNumber != nil:
+		return f.CustomFieldNumber.GetModifiedAt()
+	case f.CustomFieldDate != nil:
+		return f.CustomFieldDate.GetModifiedAt()
+	case f.CustomFieldCheckbox != nil:
+		return f.CustomFieldCheckbox.GetModifiedAt()
+	case f.CustomFieldSelect != nil:
+		return f.CustomFieldSelect.GetModifiedAt()
+	}
+	return nil
+}
+
+// id extracts the custom field ID from the active union variant.
+func (f *timestampedCustomField) id() string {
+	switch {
+	case f.CustomFieldText != nil:
+		return f.CustomFieldText.ID
+	case f.CustomFieldNumber != nil:
+		return f.CustomFieldNumber.ID
+	case f.CustomFieldDate != nil:
+		return f.CustomFieldDate.ID
+	case f.CustomFieldCheckbox != nil:
+		return f.CustomFieldCheckbox.ID
+	case f.CustomFieldSelect != nil:
+		return f.CustomFieldSelect.ID
+	}
+	return ""
+}
+
+// --- Build SDK Create request ---
+// Custom fields are polymorphic — the TF `type` field determines which SDK
+// union variant to construct. Properties blocks are optional for every type
+// except select, so a nil block maps to empty SDK properties.
+
+func buildCustomFieldCreateRequest(ctx context.Context, data *CustomFieldResourceModel) (*components.CustomFieldCreate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fieldType := data.Type.ValueString()
+	slug := data.Slug.ValueString()
+	name := data.Name.ValueString()
+	organizationID := optionalStringPointer(data.OrganizationID)
+	hasMetadata := !data.Metadata.IsNull() && !data.Metadata.IsUnknown()
+
+	var result components.CustomFieldCreate
+	switch fieldType {
+	case "text":
+		create := components.CustomFieldCreateText{Slug: slug, Name: name, OrganizationID: organizationID, Properties: textPropsToSDK(data.TextProperties)}
+		if hasMetadata {
+			m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateCustomFieldCreateTextMetadataStr)
+			diags.Append(d...)
+			create.Metadata = m
+		}
+		result = components.CreateCustomFieldCreateText(create)
+
+	case "number":
+		create := components.CustomFieldCreateNumber{Slug: slug, Name: name, OrganizationID: organizationID, Properties: numberPropsToSDK(data.NumberProperties)}
+		if hasMetadata {
+			m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateCustomFieldCreateNumberMetadataStr)
+			diags.Append(d...)
+			create.Metadata = m
+		}
+		result = components.CreateCustomFieldCreateNumber(create)
+
+	case "date":
+		create := components.CustomFieldCreateDate{Slug: slug, Name: name, OrganizationID: organizationID, Properties: datePropsToSDK(data.DateProperties)}
+		if hasMetadata {
+			m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateCustomFieldCreateDateMetadataStr)
+			diags.Append(d...)
+			create.Metadata = m
+		}
+		result = components.CreateCustomFieldCreateDate(create)
+
+	case "checkbox":
+		create := components.CustomFieldCreateCheckbox{Slug: slug, Name: name, OrganizationID: organizationID, Properties: checkboxPropsToSDK(data.CheckboxProperties)}
+		if hasMetadata {
+			m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateCustomFieldCreateCheckboxMetadataStr)
+			diags.Append(d...)
+			create.Metadata = m
+		}
+		result = components.CreateCustomFieldCreateCheckbox(create)
+
+	case "select":
+		if data.SelectProperties == nil {
+			diags.AddError("Missing properties", "select_properties is required when type is select.")
+			return nil, diags
+		}
+		create := components.CustomFieldCreateSelect{Slug: slug, Name: name, OrganizationID: organizationID, Properties: selectPropsToSDK(data.SelectProperties)}
+		if hasMetadata {
+			m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateCustomFieldCreateSelectMetadataStr)
+			diags.Append(d...)
+			create.Metadata = m
+		}
+		result = components.CreateCustomFieldCreateSelect(create)
+
+	default:
+		diags.AddError("Unsupported custom field type", fmt.Sprintf("Custom field type %q is not supported.", fieldType))
+		return nil, diags
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &result, diags
+}
+
+// --- Build SDK Update request ---
+// Same polymorphic dispatch as create. Properties are always sent in full so
+// removing an attribute from a properties block clears it on the server.
+
+func buildCustomFieldUpdateRequest(ctx context.Context, data *CustomFieldResourceModel) (*components.CustomFieldUpdate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fieldType := data.Type.ValueString()
+	slug := data.Slug.ValueString()
+	name := data.Name.ValueString()
+	hasMetadata := !data.Metadata.IsNull() && !data.Metadata.IsUnknown()
+
+	var result components.CustomFieldUpdate
+	switch fieldType {
+	case "text":
+		props := textPropsToSDK(data.TextProperties)
+		update := components.CustomFieldUpdateText{Slug: &slug, Name: &name, Properties: &props}
+		if hasMetadata {
+			m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateCustomFieldUpdateTextMetadataStr)
+			diags.Append(d...)
+			update.Metadata = m
+		}
+		result = components.CreateCustomFieldUpdateText(update)
+
+	case "number":
+		props := numberPropsToSDK(data.NumberProperties)
+		update := components.CustomFieldUpdateNumber{Slug: &slug, Name: &name, Properties: &props}
+		if hasMetadata {
+			m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateCustomFieldUpdateNumberMetadataStr)
+			diags.Append(d...)
+			update.Metadata = m
+		}
+		result = components.CreateCustomFieldUpdateNumber(update)
+
+	case "date":
+		props := datePropsToSDK(data.DateProperties)
+		update := components.CustomFieldUpdateDate{Slug: &slug, Name: &name, Properties: &props}
+		if hasMetadata {
+			m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateCustomFieldUpdateDateMetadataStr)
+			diags.Append(d...)
+			update.Metadata = m
+		}
+		result = components.CreateCustomFieldUpdateDate(update)
+
+	case "checkbox":
+		props := checkboxPropsToSDK(data.CheckboxProperties)
+		update := components.CustomFieldUpdateCheckbox{Slug: &slug, Name: &name, Properties: &props}
+		if hasMetadata {
+			m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateCustomFieldUpdateCheckboxMetadataStr)
+			diags.Append(d...)
+			update.Metadata = m
+		}
+		result = components.CreateCustomFieldUpdateCheckbox(update)
+
+	case "select":
+		update := components.CustomFieldUpdateSelect{Slug: &slug, Name: &name}
+		if data.SelectProperties != nil {
+			props := selectPropsToSDK(data.SelectProperties)
+			update.Properties = &props
+		}
+		if hasMetadata {
+			m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateCustomFieldUpdateSelectMetadataStr)
+			diags.Append(d...)
+			update.Metadata = m
+		}
+		result = components.CreateCustomFieldUpdateSelect(update)
+
+	default:
+		diags.AddError("Unsupported custom field type", fmt.Sprintf("Custom field type %q is not supported.", fieldType))
+		return nil, diags
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &result, diags
+}
+
+// --- Map SDK response to Terraform state ---
+// The API response is a union with one active variant. Properties blocks are
+// only populated when configured or when the API returns non-empty properties
+// (e.g. on import), so an omitted optional block doesn't produce a diff.
+
+func mapCustomFieldResponseToState(ctx context.Context, field *components.CustomField, data *CustomFieldResourceModel, diags *diag.Diagnostics) {
+	hadText := data.TextProperties != nil
+	hadNumber := data.NumberProperties != nil
+	hadDate := data.DateProperties != nil
+	hadCheckbox := data.CheckboxProperties != nil
+
+	// Reset all type-specific properties — the switch below sets only the active one.
+	data.TextProperties = nil
+	data.NumberProperties = nil
+	data.DateProperties = nil
+	data.CheckboxProperties = nil
+	data.SelectProperties = nil
+
+	switch {
+	case field.CustomFieldText != nil:
+		f := field.CustomFieldText
+		setCustomFieldCommonFields(f.ID, f.OrganizationID, "text", f.Slug, f.Name, data)
+		if hadText || f.Properties != (components.CustomFieldTextProperties{}) {
+			data.TextProperties = &CustomFieldTextPropertiesModel{
+				FormLabel:       optionalStringValue(f.Properties.FormLabel),
+				FormHelpText:    optionalStringValue(f.Properties.FormHelpText),
+				FormPlaceholder: optionalStringValue(f.Properties.FormPlaceholder),
+				Textarea:        optionalBoolValue(f.Properties.Textarea),
+				MinLength:       optionalInt64Value(f.Properties.MinLength),
+				MaxLength:       optionalInt64Value(f.Properties.MaxLength),
+			}
+		}
+		data.Metadata = sdkMetadataToMap(ctx, f.Metadata, func(v components.CustomFieldTextMetadata) metadataFields {
+			return metadataFields{Str: v.Str, Integer: v.Integer, Number: v.Number, Boolean: v.Boolean}
+		}, diags)
+
+	case field.CustomFieldNumber != nil:
+		f := field.CustomFieldNumber
+		setCustomFieldCommonFields(f.ID, f.OrganizationID, "number", f.Slug, f.Name, data)
+		if hadNumber || f.Properties != (components.CustomFieldNumberProperties{}) {
+			data.NumberProperties = &CustomFieldRangePropertiesModel{
+				FormLabel:       optionalStringValue(f.Properties.FormLabel),
+				FormHelpText:    optionalStringValue(f.Properties.FormHelpText),
+				FormPlaceholder: optionalStringValue(f.Properties.FormPlaceholder),
+				Ge:              optionalInt64Value(f.Properties.Ge),
+				Le:              optionalInt64Value(f.Properties.Le),
+			}
+		}
+		data.Metadata = sdkMetadataToMap(ctx, f.Metadata, func(v components.CustomFieldNumberMetadata) metadataFields {
+			return metadataFields{Str: v.Str, Integer: v.Integer, Number: v.Number, Boolean: v.Boolean}
+		}, diags)
+
+	case field.CustomFieldDate != nil:
+		f := field.CustomFieldDate
+		setCustomFieldCommonFields(f.ID, f.OrganizationID, "date", f.Slug, f.Name, data)
+		if hadDate || f.Properties != (components.CustomFieldDateProperties{}) {
+			data.DateProperties = &CustomFieldRangePropertiesModel{
+				FormLabel:       optionalStringValue(f.Properties.FormLabel),
+				FormHelpText:    optionalStringValue(f.Properties.FormHelpText),
+				FormPlaceholder: optionalStringValue(f.Properties.FormPlaceholder),
+				Ge:              optionalInt64Value(f.Properties.Ge),
+				Le:              optionalInt64Value(f.Properties.Le),
+			}
+		}
+		data.Metadata = sdkMetadataToMap(ctx, f.Metadata, func(v components.CustomFieldDateMetadata) metadataFields {
+			return metadataFields{Str: v.Str, Integer: v.Integer, Number: v.Number, Boolean: v.Boolean}
+		}, diags)
+
+	case field.CustomFieldCheckbox != nil:
+		f := field.CustomFieldCheckbox
+		setCustomFieldCommonFields(f.ID, f.OrganizationID, "checkbox", f.Slug, f.Name, data)
+		if hadCheckbox || f.Properties != (components.CustomFieldCheckboxProperties{}) {
+			data.CheckboxProperties = &CustomFieldCheckboxPropertiesModel{
+				FormLabel:       optionalStringValue(f.Properties.FormLabel),
+				FormHelpText:    optionalStringValue(f.Properties.FormHelpText),
+				FormPlaceholder: optionalStringValue(f.Properties.FormPlaceholder),
+			}
+		}
+		data.Metadata = sdkMetadataToMap(ctx, f.Metadata, func(v components.CustomFieldCheckboxMetadata) metadataFields {
+			return metadataFields{Str: v.Str, Integer: v.Integer, Number: v.Number, Boolean: v.Boolean}
+		}, diags)
+
+	case field.CustomFieldSelect != nil:
+		f := field.CustomFieldSelect
+		setCustomFieldCommonFields(f.ID, f.OrganizationID, "select", f.Slug, f.Name, data)
+		options := make([]CustomFieldSelectOptionModel, len(f.Properties.Options))
+		for i, o := range f.Properties.Options {
+			options[i] = CustomFieldSelectOptionModel{
+				Value: types.StringValue(o.Value),
+				Label: types.StringValue(o.Label),
+			}
+		}
+		data.SelectProperties = &CustomFieldSelectPropertiesModel{
+			FormLabel:       optionalStringValue(f.Properties.FormLabel),
+			FormHelpText:    optionalStringValue(f.Properties.FormHelpText),
+			FormPlaceholder: optionalStringValue(f.Properties.FormPlaceholder),
+			Options:         options,
+		}
+		data.Metadata = sdkMetadataToMap(ctx, f.Metadata, func(v components.CustomFieldSelectMetadata) metadataFields {
+			return metadataFields{Str: v.Str, Integer: v.Integer, Number: v.Number, Boolean: v.Boolean}
+		}, diags)
+
+	default:
+		diags.AddError("Unknown custom field type", "Could not determine custom field type from API response.")
+	}
+}
+
+// --- Shared helpers ---
+
+func setCustomFieldCommonFields(id, organizationID, fieldType, slug, name string, data *CustomFieldResourceModel) {
+	data.ID = types.StringValue(id)
+	data.OrganizationID = types.StringValue(organizationID)
+	data.Type = types.StringValue(fieldType)
+	data.Slug = types.StringValue(slug)
+	data.Name = types.StringValue(name)
+}
+
+func textPropsToSDK(p *CustomFieldTextPropertiesModel) components.CustomFieldTextProperties {
+	if p == nil {
+		return components.CustomFieldTextProperties{}
+	}
+	return components.CustomFieldTextProperties{
+		FormLabel:       optionalStringPointer(p.FormLabel),
+		FormHelpText:    optionalStringPointer(p.FormHelpText),
+		FormPlaceholder: optionalStringPointer(p.FormPlaceholder),
+		Textarea:        optionalBoolPointer(p.Textarea),
+		MinLength:       optionalInt64Pointer(p.MinLength),
+		MaxLength:       optionalInt64Pointer(p.MaxLength),
+	}
+}
+
+func numberPropsToSDK(p *CustomFieldRangePropertiesModel) components.CustomFieldNumberProperties {
+	if p == nil {
+		return components.CustomFieldNumberProperties{}
+	}
+	return components.CustomFieldNumberProperties{
+		FormLabel:       optionalStringPointer(p.FormLabel),
+		FormHelpText:    optionalStringPointer(p.FormHelpText),
+		FormPlaceholder: optionalStringPointer(p.FormPlaceholder),
+		Ge:              optionalInt64Pointer(p.Ge),
+		Le:              optionalInt64Pointer(p.Le),
+	}
+}
+
+func datePropsToSDK(p *CustomFieldRangePropertiesModel) components.CustomFieldDateProperties {
+	if p == nil {
+		return components.CustomFieldDateProperties{}
+	}
+	return components.CustomFieldDateProperties{
+		FormLabel:       optionalStringPointer(p.FormLabel),
+		FormHelpText:    optionalStringPointer(p.FormHelpText),
+		FormPlaceholder: optionalStringPointer(p.FormPlaceholder),
+		Ge:              optionalInt64Pointer(p.Ge),
+		Le:              optionalInt64Pointer(p.Le),
+	}
+}
+
+func checkboxPropsToSDK(p *CustomFieldCheckboxPropertiesModel) components.CustomFieldCheckboxProperties {
+	if p == nil {
+		return components.CustomFieldCheckboxProperties{}
+	}
+	return components.CustomFieldCheckboxProperties{
+		FormLabel:       optionalStringPointer(p.FormLabel),
+		FormHelpText:    optionalStringPointer(p.FormHelpText),
+		FormPlaceholder: optionalStringPointer(p.FormPlaceholder),
+	}
+}
+
+func selectPropsToSDK(p *CustomFieldSelectPropertiesModel) components.CustomFieldSelectProperties {
+	options := make([]components.CustomFieldSelectOption, len(p.Options))
+	for i, o := range p.Options {
+		options[i] = components.CustomFieldSelectOption{
+			Value: o.Value.ValueString(),
+			Label: o.Label.ValueString(),
+		}
+	}
+	return components.CustomFieldSelectProperties{
+		FormLabel:       optionalStringPointer(p.FormLabel),
+		FormHelpText:    optionalStringPointer(p.FormHelpText),
+		FormPlaceholder: optionalStringPointer(p.FormPlaceholder),
+		Options:         options,
+	}
+}
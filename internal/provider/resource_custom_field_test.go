@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/polarsource/polar-go/models/components"
+)
+
+func TestAccCustomFieldResource_text(t *testing.T) {
+	slug := fmt.Sprintf("tf-acc-%s", strings.ToLower(acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccCustomFieldTextConfig(slug, "VAT Number", "VAT"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_custom_field.test",
+						tfjsonpath.New("type"),
+						knownvalue.StringExact("text"),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_custom_field.test",
+						tfjsonpath.New("text_properties").AtMapKey("form_label"),
+						knownvalue.StringExact("VAT"),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_custom_field.test",
+						tfjsonpath.New("select_properties"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			// ImportState
+			{
+				ResourceName:      "polar_custom_field.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update name and label in place
+			{
+				Config: testAccCustomFieldTextConfig(slug, "VAT ID", "VAT ID"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_custom_field.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("VAT ID"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCustomFieldResource_select(t *testing.T) {
+	slug := fmt.Sprintf("tf-acc-%s", strings.ToLower(acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomFieldSelectConfig(slug),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_custom_field.test",
+						tfjsonpath.New("select_properties").AtMapKey("options"),
+						knownvalue.ListSizeExact(3),
+					),
+				},
+			},
+			{
+				ResourceName:      "polar_custom_field.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestCustomFieldResource_conflictingProperties(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "polar_custom_field" "test" {
+  type = "text"
+  slug = "team-size"
+  name = "Team size"
+
+  number_properties = {
+    ge = 1
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`"number_properties" cannot be set when type is "text"`),
+			},
+			{
+				Config: `
+resource "polar_custom_field" "test" {
+  type = "select"
+  slug = "team-size"
+  name = "Team size"
+}
+`,
+				ExpectError: regexp.MustCompile(`select_properties is required when type is "select"`),
+			},
+		},
+	})
+}
+
+func TestMapCustomFieldResponseToState_optionalProperties(t *testing.T) {
+	ctx := context.Background()
+	label := "Company"
+	tests := []struct {
+		name      string
+		props     components.CustomFieldCheckboxProperties
+		prior     *CustomFieldCheckboxPropertiesModel
+		wantBlock bool
+	}{
+		{"omitted block stays null", components.CustomFieldCheckboxProperties{}, nil, false},
+		{"configured empty block is kept", components.CustomFieldCheckboxProperties{}, &CustomFieldCheckboxPropertiesModel{}, true},
+		{"import populates non-empty block", components.CustomFieldCheckboxProperties{FormLabel: &label}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field := components.CreateCustomFieldCheckbox(components.CustomFieldCheckbox{
+				ID:         "cf_123",
+				Slug:       "terms",
+				Name:       "Terms",
+				Properties: tt.props,
+			})
+			data := CustomFieldResourceModel{CheckboxProperties: tt.prior}
+			var diags diag.Diagnostics
+			mapCustomFieldResponseToState(ctx, &field, &data, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got := data.CheckboxProperties != nil; got != tt.wantBlock {
+				t.Errorf("checkbox_properties set = %v, want %v", got, tt.wantBlock)
+			}
+			if got := data.Type.ValueString(); got != "checkbox" {
+				t.Errorf("type = %q, want %q", got, "checkbox")
+			}
+		})
+	}
+}
+
+func TestMapCustomFieldResponseToState_select(t *testing.T) {
+	ctx := context.Background()
+	field := components.CreateCustomFieldSelect(components.CustomFieldSelect{
+		ID:   "cf_456",
+		Slug: "team-size",
+		Name: "Team size",
+		Properties: components.CustomFieldSelectProperties{
+			Options: []components.CustomFieldSelectOption{
+				{Value: "small", Label: "1-10"},
+				{Value: "large", Label: "11+"},
+			},
+		},
+	})
+
+	// A stale text block from a previous type must be cleared.
+	data := CustomFieldResourceModel{TextProperties: &CustomFieldTextPropertiesModel{Textarea: types.BoolValue(true)}}
+	var diags diag.Diagnostics
+	mapCustomFieldResponseToState(ctx, &field, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if data.TextProperties != nil {
+		t.Error("text_properties should be cleared for a select field")
+	}
+	if data.SelectProperties == nil {
+		t.Fatal("select_properties should be set")
+	}
+	if got := len(data.SelectProperties.Options); got != 2 {
+		t.Fatalf("got %d options, want 2", got)
+	}
+	if got := data.SelectProperties.Options[1].Label.ValueString(); got != "11+" {
+		t.Errorf("second option label = %q, want %q", got, "11+")
+	}
+}
+
+// --- Config helpers ---
+
+func testAccCustomFieldTextConfig(slug, name, formLabel string) string {
+	return fmt.Sprintf(`
+resource "polar_custom_field" "test" {
+  type = "text"
+  slug = %q
+  name = %q
+
+  text_properties = {
+    form_label = %q
+    max_length = 32
+  }
+}
+`, slug, name, formLabel)
+}
+
+func testAccCustomFieldSelectConfig(slug string) string {
+	return fmt.Sprintf(`
+resource "polar_custom_field" "test" {
+  type = "select"
+  slug = %q
+  name = "Team size"
+
+  select_properties = {
+    options = [
+      { value = "small", label = "1-10" },
+      { value = "medium", label = "11-50" },
+      { value = "large", label = "51+" },
+    ]
+  }
+}
+`, slug)
+}
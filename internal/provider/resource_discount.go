@@ -0,0 +1,481 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/polarsource/polar-go"
+)
+
+// Compile-time interface conformance checks.
+var _ resource.Resource = &DiscountResource{}
+var _ resource.ResourceWithImportState = &DiscountResource{}
+var _ resource.ResourceWithValidateConfig = &DiscountResource{}
+
+func NewDiscountResource() resource.Resource {
+	return &DiscountResource{}
+}
+
+type DiscountResource struct {
+	client         *polargo.Polar
+	supplemental   *supplementalClient // clears fields DiscountUpdate can't unset
+	organizationID string              // provider default, see resolveOrganizationID
+	consistency    consistencyPolicy   // provider consistency settings, see pollForConsistency
+	retry          retryPolicy         // provider retry settings, see retryPolicy.createOption
+}
+
+// --- Terraform model types ---
+
+// DiscountResourceModel is the TF state for polar_discount.
+// Discounts are polymorphic along two axes, mirroring the SDK union:
+// - type:     "percentage" (basis_points) or "fixed" (amount, currency)
+// - duration: "once", "forever", or "repeating" (duration_in_months)
+// Fields that don't apply to the configured type/duration are null in state.
+type DiscountResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationID   types.String `tfsdk:"organization_id"`
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	BasisPoints      types.Int64  `tfsdk:"basis_points"`
+	Amount           types.Int64  `tfsdk:"amount"`
+	Currency         types.String `tfsdk:"currency"`
+	Duration         types.String `tfsdk:"duration"`
+	DurationInMonths types.Int64  `tfsdk:"duration_in_months"`
+	Code             types.String `tfsdk:"code"`
+	StartsAt         types.String `tfsdk:"starts_at"`
+	EndsAt           types.String `tfsdk:"ends_at"`
+	MaxRedemptions   types.Int64  `tfsdk:"max_redemptions"`
+	RedemptionsCount types.Int64  `tfsdk:"redemptions_count"`
+	ProductIDs       types.Set    `tfsdk:"product_ids"`
+	Metadata         types.Map    `tfsdk:"metadata"`
+
+	Consistency *ConsistencyOverrideModel `tfsdk:"consistency"`
+}
+
+// --- Resource interface ---
+
+func (r *DiscountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_discount"
+}
+
+func (r *DiscountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Polar discount. Discounts reduce the price of a checkout by a percentage or a fixed amount, either once, forever, or for a number of months. Set `code` to let customers redeem the discount at checkout.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The discount ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": organizationIDAttribute("discount"),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the discount. Displayed to the customer when the discount is applied.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The discount type. Must be `percentage` or `fixed`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("percentage", "fixed"),
+				},
+			},
+			"basis_points": schema.Int64Attribute{
+				MarkdownDescription: "The discount percentage in basis points (1/100th of a percent), e.g. `2550` for 25.5%. Required when `type` is `percentage`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10000),
+				},
+			},
+			"amount": schema.Int64Attribute{
+				MarkdownDescription: "The fixed amount in cents to discount from the invoice total. Required when `type` is `fixed`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "The currency code for `fixed` discounts. Defaults to `usd`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"duration": schema.StringAttribute{
+				MarkdownDescription: "How long the discount applies to a subscription. Must be one of: `once`, `forever`, `repeating`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("once", "forever", "repeating"),
+				},
+			},
+			"duration_in_months": schema.Int64Attribute{
+				MarkdownDescription: "Number of months the discount applies. Required when `duration` is `repeating`. For yearly prices, multiply by 12.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Code customers can use to apply the discount at checkout. Must be 3-256 alphanumeric characters. Omit to only allow applying the discount via the API or checkout links.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9]{3,256}$`),
+						"discount code must be 3-256 alphanumeric characters",
+					),
+				},
+			},
+			"starts_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp after which the discount is redeemable.",
+				Optional:            true,
+			},
+			"ends_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp after which the discount is no longer redeemable.",
+				Optional:            true,
+			},
+			"max_redemptions": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times the discount can be redeemed.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"redemptions_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of times the discount has been redeemed.",
+				Computed:            true,
+			},
+			"product_ids": schema.SetAttribute{
+				MarkdownDescription: "Set of product IDs the discount is restricted to. Omit to allow the discount on all products.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Key-value metadata.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"consistency": consistencyOverrideAttribute(),
+		},
+	}
+}
+
+func (r *DiscountResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DiscountResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateMetadata(ctx, data.Metadata, &resp.Diagnostics)
+
+	// --- Type-specific fields ---
+	if !data.Type.IsUnknown() {
+		discountType := data.Type.ValueString()
+		switch discountType {
+		case "percentage":
+			if data.BasisPoints.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("basis_points"),
+					"Missing required field",
+					"basis_points is required when type is \"percentage\".",
+				)
+			}
+			if !data.Amount.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("amount"),
+					"Unexpected field",
+					"amount is not used when type is \"percentage\".",
+				)
+			}
+			if !data.Currency.IsNull() && !data.Currency.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("currency"),
+					"Unexpected field",
+					"currency is not used when type is \"percentage\".",
+				)
+			}
+		case "fixed":
+			if data.Amount.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("amount"),
+					"Missing required field",
+					"amount is required when type is \"fixed\".",
+				)
+			}
+			if !data.BasisPoints.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("basis_points"),
+					"Unexpected field",
+					"basis_points is not used when type is \"fixed\".",
+				)
+			}
+		}
+	}
+
+	// --- Duration-specific fields ---
+	if !data.Duration.IsUnknown() {
+		duration := data.Duration.ValueString()
+		if duration == "repeating" && data.DurationInMonths.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("duration_in_months"),
+				"Missing required field",
+				"duration_in_months is required when duration is \"repeating\".",
+			)
+		}
+		if duration != "repeating" && !data.DurationInMonths.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("duration_in_months"),
+				"Unexpected field",
+				fmt.Sprintf("duration_in_months is not used when duration is %q.", duration),
+			)
+		}
+	}
+
+	// --- Redemption window ---
+	startsAt := validateTimestamp(data.StartsAt, path.Root("starts_at"), &resp.Diagnostics)
+	endsAt := validateTimestamp(data.EndsAt, path.Root("ends_at"), &resp.Diagnostics)
+	if startsAt != nil && endsAt != nil && !endsAt.After(*startsAt) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ends_at"),
+			"Invalid redemption window",
+			fmt.Sprintf("ends_at (%s) must be after starts_at (%s).", data.EndsAt.ValueString(), data.StartsAt.ValueString()),
+		)
+	}
+}
+
+func (r *DiscountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
+		r.client = pd.Client
+		r.supplemental = pd.Supplemental
+		r.consistency = pd.Consistency
+		r.retry = pd.Retry
+		r.organizationID = pd.OrganizationID
+	}
+}
+
+// Create: plan → build type/duration-specific SDK request → call API → poll → save state.
+func (r *DiscountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withCorrelationID(ctx)
+	var data DiscountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build the SDK request — dispatches by type × duration to one of four union variants.
+	data.OrganizationID = resolveOrganizationID(data.OrganizationID, r.organizationID)
+	createReq, diags := buildDiscountCreateRequest(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Discounts.Create(ctx, *createReq, r.retry.createOption())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating discount",
+			fmt.Sprintf("Could not create discount: %s", err),
+		)
+		return
+	}
+
+	// Discount SDK response is a union — timestampedDiscount adapts it for polling.
+	created := &timestampedDiscount{result.Discount}
+	id := created.id()
+
+	tflog.Trace(ctx, "created discount", map[string]interface{}{
+		"id": id,
+	})
+
+	writeTime := latestTimestamp(created)
+	discount, err := pollForConsistency(ctx, r.consistency.withOverride(data.Consistency), "discount", id, writeTime, func() (*timestampedDiscount, error) {
+		result, err := r.client.Discounts.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &timestampedDiscount{result.Discount}, nil
+	}, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for discount visibility",
+			fmt.Sprintf("Discount %s was created but not immediately readable: %s", id, err),
+		)
+		return
+	}
+
+	planned := data
+	mapDiscountResponseToState(ctx, discount.Discount, &data, &resp.Diagnostics)
+	preserveTimestampFormatting(&data.StartsAt, planned.StartsAt)
+	preserveTimestampFormatting(&data.EndsAt, planned.EndsAt)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes TF state from the API. Deleted discounts (404) are removed from state.
+func (r *DiscountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = withCorrelationID(ctx)
+	var data DiscountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior := data
+	result, err := r.client.Discounts.Get(ctx, data.ID.ValueString())
+	if err != nil {
+		if handleNotFoundRemove(ctx, err, "discount", data.ID.ValueString(), &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading discount",
+			fmt.Sprintf("Could not read discount %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	mapDiscountResponseToState(ctx, result.Discount, &data, &resp.Diagnostics)
+	preserveTimestampFormatting(&data.StartsAt, prior.StartsAt)
+	preserveTimestampFormatting(&data.EndsAt, prior.EndsAt)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update: plan → clear removed fields → build SDK request → call API → poll
+// for consistency → save state.
+func (r *DiscountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = withCorrelationID(ctx)
+	var data, prior DiscountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := buildDiscountUpdateRequest(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clearPayload := buildDiscountClearPayload(&data, &prior)
+	if err := patchClearedFields(ctx, r.supplemental, "/v1/discounts/"+url.PathEscape(data.ID.ValueString()), clearPayload); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating discount",
+			fmt.Sprintf("Could not clear removed fields on discount %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	result, err := r.client.Discounts.Update(ctx, data.ID.ValueString(), *updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating discount",
+			fmt.Sprintf("Could not update discount %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	discountID := data.ID.ValueString()
+	writeTime := latestTimestamp(&timestampedDiscount{result.Discount})
+	discount, err := pollForConsistency(ctx, r.consistency.withOverride(data.Consistency), "discount", discountID, writeTime, func() (*timestampedDiscount, error) {
+		result, err := r.client.Discounts.Get(ctx, discountID)
+		if err != nil {
+			return nil, err
+		}
+		return &timestampedDiscount{result.Discount}, nil
+	}, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading discount after update",
+			fmt.Sprintf("Could not read discount %s: %s", discountID, err),
+		)
+		return
+	}
+
+	planned := data
+	mapDiscountResponseToState(ctx, discount.Discount, &data, &resp.Diagnostics)
+	preserveTimestampFormatting(&data.StartsAt, planned.StartsAt)
+	preserveTimestampFormatting(&data.EndsAt, planned.EndsAt)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete performs a real DELETE. Existing subscriptions keep the discount
+// they were granted; only new redemptions are prevented.
+func (r *DiscountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withCorrelationID(ctx)
+	var data DiscountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Discounts.Delete(ctx, data.ID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting discount",
+			fmt.Sprintf("Could not delete discount %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted discount", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *DiscountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// validateTimestamp checks that a configured timestamp is valid RFC 3339 and
+// returns the parsed value. Returns nil for null/unknown or invalid values.
+func validateTimestamp(value types.String, attrPath path.Path, diags *diag.Diagnostics) *time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			attrPath,
+			"Invalid timestamp",
+			fmt.Sprintf("%q is not a valid RFC 3339 timestamp (e.g. \"2025-01-31T00:00:00Z\").", value.ValueString()),
+		)
+		return nil
+	}
+	return &t
+}
+
+// preserveTimestampFormatting keeps the user's timestamp formatting when the
+// API returns the same instant in a different representation (e.g. a "+02:00"
+// offset normalized to UTC).
+func preserveTimestampFormatting(current *types.String, prior types.String) {
+	if current.IsNull() || prior.IsNull() || prior.IsUnknown() {
+		return
+	}
+	a, aErr := time.Parse(time.RFC3339, current.ValueString())
+	b, bErr := time.Parse(time.RFC3339, prior.ValueString())
+	if aErr == nil && bErr == nil && a.Equal(b) {
+		*current = prior
+	}
+}
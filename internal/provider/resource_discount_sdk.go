@@ -0,0 +1,368 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/polarsource/polar-go/models/components"
+)
+
+// timestampedDiscount wraps *components.Discount (a union type) to satisfy the
+// Timestamped interface. Same adapter pattern as timestampedBenefit: the SDK
+// models each type × duration combination as a distinct struct.
+type timestampedDiscount struct{ *components.Discount }
+
+func (d *timestampedDiscount) GetCreatedAt() time.Time {
+	switch {
+	case d.DiscountFixedOnceForeverDuration != nil:
+		return d.DiscountFixedOnceForeverDuration.GetCreatedAt()
+	case d.DiscountFixedRepeatDuration != nil:
+		return d.DiscountFixedRepeatDuration.GetCreatedAt()
+	case d.DiscountPercentageOnceForeverDuration != nil:
+		return d.DiscountPercentageOnceForeverDuration.GetCreatedAt()
+	case d.DiscountPercentageRepeatDuration != nil:
+		return d.DiscountPercentageRepeatDuration.GetCreatedAt()
+	}
+	return time.Time{}
+}
+
+func (d *timestampedDiscount) GetModifiedAt() *time.Time {
+	switch {
+	case d.DiscountFixedOnceForeverDuration != nil:
+		return d.DiscountFixedOnceForeverDuration.GetModifiedAt()
+	case d.DiscountFixedRepeatDuration != nil:
+		return d.DiscountFixedRepeatDuration.GetModifiedAt()
+	case d.DiscountPercentageOnceForeverDuration != nil:
+		return d.DiscountPercentageOnceForeverDuration.GetModifiedAt()
+	case d.DiscountPercentageRepeatDuration != nil:
+		return d.DiscountPercentageRepeatDuration.GetModifiedAt()
+	}
+	return nil
+}
+
+// id extracts the discount ID from the active union variant.
+func (d *timestampedDiscount) id() string {
+	switch {
+	case d.DiscountFixedOnceForeverDuration != nil:
+		return d.DiscountFixedOnceForeverDuration.ID
+	case d.DiscountFixedRepeatDuration != nil:
+		return d.DiscountFixedRepeatDuration.ID
+	case d.DiscountPercentageOnceForeverDuration != nil:
+		return d.DiscountPercentageOnceForeverDuration.ID
+	case d.DiscountPercentageRepeatDuration != nil:
+		return d.DiscountPercentageRepeatDuration.ID
+	}
+	return ""
+}
+
+// --- Build SDK Create request ---
+// The SDK splits discounts into four create variants (fixed/percentage ×
+// once-or-forever/repeating). Common fields are extracted once and copied
+// into whichever variant matches the configured type and duration.
+
+// discountCommonCreate holds fields shared by all four create variants.
+type discountCommonCreate struct {
+	name           string
+	code           *string
+	startsAt       *time.Time
+	endsAt         *time.Time
+	maxRedemptions *int64
+	products       []string
+	organizationID *string
+}
+
+func buildDiscountCommonCreate(ctx context.Context, data *DiscountResourceModel, diags *diag.Diagnostics) discountCommonCreate {
+	common := discountCommonCreate{
+		name:           data.Name.ValueString(),
+		code:           optionalStringPointer(data.Code),
+		startsAt:       parseOptionalTimestamp(data.StartsAt, diags),
+		endsAt:         parseOptionalTimestamp(data.EndsAt, diags),
+		maxRedemptions: optionalInt64Pointer(data.MaxRedemptions),
+		organizationID: optionalStringPointer(data.OrganizationID),
+	}
+	if !data.ProductIDs.IsNull() && !data.ProductIDs.IsUnknown() {
+		diags.Append(data.ProductIDs.ElementsAs(ctx, &common.products, false)...)
+	}
+	return common
+}
+
+func buildDiscountCreateRequest(ctx context.Context, data *DiscountResourceModel) (*components.DiscountCreate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	common := buildDiscountCommonCreate(ctx, data, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	discountType := components.DiscountType(data.Type.ValueString())
+	duration := components.DiscountDuration(data.Duration.ValueString())
+	repeating := duration == components.DiscountDurationRepeating
+
+	var result components.DiscountCreate
+	switch {
+	case discountType == components.DiscountTypePercentage && repeating:
+		create := components.DiscountPercentageRepeatDurationCreate{
+			Duration:         duration,
+			DurationInMonths: data.DurationInMonths.ValueInt64(),
+			Type:             discountType,
+			BasisPoints:      data.BasisPoints.ValueInt64(),
+			Name:             common.name,
+			Code:             common.code,
+			StartsAt:         common.startsAt,
+			EndsAt:           common.endsAt,
+			MaxRedemptions:   common.maxRedemptions,
+			Products:         common.products,
+			OrganizationID:   common.organizationID,
+		}
+		if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+			m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateDiscountPercentageRepeatDurationCreateMetadataStr)
+			diags.Append(d...)
+			create.Metadata = m
+		}
+		result = components.CreateDiscountCreateDiscountPercentageRepeatDurationCreate(create)
+
+	case discountType == components.DiscountTypePercentage:
+		create := components.DiscountPercentageOnceForeverDurationCreate{
+			Duration:       duration,
+			Type:           discountType,
+			BasisPoints:    data.BasisPoints.ValueInt64(),
+			Name:           common.name,
+			Code:           common.code,
+			StartsAt:       common.startsAt,
+			EndsAt:         common.endsAt,
+			MaxRedemptions: common.maxRedemptions,
+			Products:       common.products,
+			OrganizationID: common.organizationID,
+		}
+		if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+			m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateDiscountPercentageOnceForeverDurationCreateMetadataStr)
+			diags.Append(d...)
+			create.Metadata = m
+		}
+		result = components.CreateDiscountCreateDiscountPercentageOnceForeverDurationCreate(create)
+
+	case discountType == components.DiscountTypeFixed && repeating:
+		create := components.DiscountFixedRepeatDurationCreate{
+			Duration:         duration,
+			DurationInMonths: data.DurationInMonths.ValueInt64(),
+			Type:             discountType,
+			Amount:           data.Amount.ValueInt64(),
+			Currency:         optionalStringPointer(data.Currency),
+			Name:             common.name,
+			Code:             common.code,
+			StartsAt:         common.startsAt,
+			EndsAt:           common.endsAt,
+			MaxRedemptions:   common.maxRedemptions,
+			Products:         common.products,
+			OrganizationID:   common.organizationID,
+		}
+		if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+			m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateDiscountFixedRepeatDurationCreateMetadataStr)
+			diags.Append(d...)
+			create.Metadata = m
+		}
+		result = components.CreateDiscountCreateDiscountFixedRepeatDurationCreate(create)
+
+	case discountType == components.DiscountTypeFixed:
+		create := components.DiscountFixedOnceForeverDurationCreate{
+			Duration:       duration,
+			Type:           discountType,
+			Amount:         data.Amount.ValueInt64(),
+			Currency:       optionalStringPointer(data.Currency),
+			Name:           common.name,
+			Code:           common.code,
+			StartsAt:       common.startsAt,
+			EndsAt:         common.endsAt,
+			MaxRedemptions: common.maxRedemptions,
+			Products:       common.products,
+			OrganizationID: common.organizationID,
+		}
+		if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+			m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateDiscountFixedOnceForeverDurationCreateMetadataStr)
+			diags.Append(d...)
+			create.Metadata = m
+		}
+		result = components.CreateDiscountCreateDiscountFixedOnceForeverDurationCreate(create)
+
+	default:
+		diags.AddError("Unsupported discount type", fmt.Sprintf("Discount type %q is not supported.", discountType))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &result, diags
+}
+
+// --- Build SDK Update request ---
+// Unlike create, the update payload is a single flat struct. Type-specific
+// fields are only sent when they apply to the configured type/duration.
+
+func buildDiscountUpdateRequest(ctx context.Context, data *DiscountResourceModel) (*components.DiscountUpdate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	common := buildDiscountCommonCreate(ctx, data, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	discountType := components.DiscountType(data.Type.ValueString())
+	duration := components.DiscountDuration(data.Duration.ValueString())
+	update := components.DiscountUpdate{
+		Name:           &common.name,
+		Code:           common.code,
+		StartsAt:       common.startsAt,
+		EndsAt:         common.endsAt,
+		MaxRedemptions: common.maxRedemptions,
+		Duration:       &duration,
+		Type:           &discountType,
+		Products:       common.products,
+	}
+
+	switch discountType {
+	case components.DiscountTypePercentage:
+		update.BasisPoints = optionalInt64Pointer(data.BasisPoints)
+	case components.DiscountTypeFixed:
+		update.Amount = optionalInt64Pointer(data.Amount)
+		update.Currency = optionalStringPointer(data.Currency)
+	}
+	if duration == components.DiscountDurationRepeating {
+		update.DurationInMonths = optionalInt64Pointer(data.DurationInMonths)
+	}
+
+	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+		m, d := metadataToCreateSDK(ctx, data.Metadata, components.CreateDiscountUpdateMetadataStr)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		update.Metadata = m
+	}
+
+	return &update, diags
+}
+
+// buildDiscountClearPayload returns the fields set in prior state that the plan
+// removes or empties, as the explicit null or empty value that clears them.
+// DiscountUpdate can't carry these (see clearRemovedField), so Update sends
+// them through the supplemental client.
+func buildDiscountClearPayload(data, prior *DiscountResourceModel) map[string]any {
+	payload := map[string]any{}
+	clearRemovedField(payload, "code", data.Code, prior.Code, nil)
+	clearRemovedField(payload, "starts_at", data.StartsAt, prior.StartsAt, nil)
+	clearRemovedField(payload, "ends_at", data.EndsAt, prior.EndsAt, nil)
+	clearRemovedField(payload, "max_redemptions", data.MaxRedemptions, prior.MaxRedemptions, nil)
+	clearRemovedField(payload, "products", data.ProductIDs, prior.ProductIDs, []string{})
+	clearRemovedField(payload, "metadata", data.Metadata, prior.Metadata, map[string]any{})
+	return payload
+}
+
+// --- Map SDK response to Terraform state ---
+
+// mapDiscountResponseToState converts the discount union into the flat TF model.
+// Fields that don't belong to the active variant are set to null.
+func mapDiscountResponseToState(ctx context.Context, discount *components.Discount, data *DiscountResourceModel, diags *diag.Diagnostics) {
+	data.BasisPoints = types.Int64Null()
+	data.Amount = types.Int64Null()
+	data.Currency = types.StringNull()
+	data.DurationInMonths = types.Int64Null()
+
+	var products []components.DiscountProduct
+	switch {
+	case discount.DiscountFixedOnceForeverDuration != nil:
+		d := discount.DiscountFixedOnceForeverDuration
+		setDiscountCommonFields(d.ID, d.OrganizationID, d.Name, d.Type, d.Duration, d.Code, d.StartsAt, d.EndsAt, d.MaxRedemptions, d.RedemptionsCount, data)
+		data.Amount = types.Int64Value(d.Amount)
+		data.Currency = types.StringValue(d.Currency)
+		data.Metadata = sdkMetadataToMap(ctx, d.Metadata, func(v components.DiscountFixedOnceForeverDurationMetadata) metadataFields {
+			return metadataFields{Str: v.Str, Integer: v.Integer, Number: v.Number, Boolean: v.Boolean}
+		}, diags)
+		products = d.Products
+
+	case discount.DiscountFixedRepeatDuration != nil:
+		d := discount.DiscountFixedRepeatDuration
+		setDiscountCommonFields(d.ID, d.OrganizationID, d.Name, d.Type, d.Duration, d.Code, d.StartsAt, d.EndsAt, d.MaxRedemptions, d.RedemptionsCount, data)
+		data.Amount = types.Int64Value(d.Amount)
+		data.Currency = types.StringValue(d.Currency)
+		data.DurationInMonths = types.Int64Value(d.DurationInMonths)
+		data.Metadata = sdkMetadataToMap(ctx, d.Metadata, func(v components.DiscountFixedRepeatDurationMetadata) metadataFields {
+			return metadataFields{Str: v.Str, Integer: v.Integer, Number: v.Number, Boolean: v.Boolean}
+		}, diags)
+		products = d.Products
+
+	case discount.DiscountPercentageOnceForeverDuration != nil:
+		d := discount.DiscountPercentageOnceForeverDuration
+		setDiscountCommonFields(d.ID, d.OrganizationID, d.Name, d.Type, d.Duration, d.Code, d.StartsAt, d.EndsAt, d.MaxRedemptions, d.RedemptionsCount, data)
+		data.BasisPoints = types.Int64Value(d.BasisPoints)
+		data.Metadata = sdkMetadataToMap(ctx, d.Metadata, func(v components.DiscountPercentageOnceForeverDurationMetadata) metadataFields {
+			return metadataFields{Str: v.Str, Integer: v.Integer, Number: v.Number, Boolean: v.Boolean}
+		}, diags)
+		products = d.Products
+
+	case discount.DiscountPercentageRepeatDuration != nil:
+		d := discount.DiscountPercentageRepeatDuration
+		setDiscountCommonFields(d.ID, d.OrganizationID, d.Name, d.Type, d.Duration, d.Code, d.StartsAt, d.EndsAt, d.MaxRedemptions, d.RedemptionsCount, data)
+		data.BasisPoints = types.Int64Value(d.BasisPoints)
+		data.DurationInMonths = types.Int64Value(d.DurationInMonths)
+		data.Metadata = sdkMetadataToMap(ctx, d.Metadata, func(v components.DiscountPercentageRepeatDurationMetadata) metadataFields {
+			return metadataFields{Str: v.Str, Integer: v.Integer, Number: v.Number, Boolean: v.Boolean}
+		}, diags)
+		products = d.Products
+
+	default:
+		diags.AddError("Unknown discount type", "Could not determine discount type from API response.")
+		return
+	}
+
+	// Map product_ids — only if the user opted into a product restriction (non-null).
+	// Same pattern as benefit_ids on products.
+	if !data.ProductIDs.IsNull() {
+		ids := make([]string, len(products))
+		for i, p := range products {
+			ids[i] = p.ID
+		}
+		productSet, d := types.SetValueFrom(ctx, types.StringType, ids)
+		diags.Append(d...)
+		data.ProductIDs = productSet
+	}
+}
+
+// --- Shared helpers ---
+
+func setDiscountCommonFields(id, organizationID, name string, discountType components.DiscountType, duration components.DiscountDuration, code *string, startsAt, endsAt *time.Time, maxRedemptions *int64, redemptionsCount int64, data *DiscountResourceModel) {
+	data.ID = types.StringValue(id)
+	data.OrganizationID = types.StringValue(organizationID)
+	data.Name = types.StringValue(name)
+	data.Type = types.StringValue(string(discountType))
+	data.Duration = types.StringValue(string(duration))
+	data.Code = optionalStringValue(code)
+	data.StartsAt = optionalTimestampValue(startsAt)
+	data.EndsAt = optionalTimestampValue(endsAt)
+	data.MaxRedemptions = optionalInt64Value(maxRedemptions)
+	data.RedemptionsCount = types.Int64Value(redemptionsCount)
+}
+
+// parseOptionalTimestamp parses an RFC 3339 string attribute, returning nil for null/unknown.
+// Format errors are normally caught by ValidateConfig; this is a last line of defense.
+func parseOptionalTimestamp(s types.String, diags *diag.Diagnostics) *time.Time {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, s.ValueString())
+	if err != nil {
+		diags.AddError("Invalid timestamp", fmt.Sprintf("%q is not a valid RFC 3339 timestamp: %s", s.ValueString(), err))
+		return nil
+	}
+	return &t
+}
+
+// optionalTimestampValue formats a *time.Time as RFC 3339, returning null if nil.
+func optionalTimestampValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
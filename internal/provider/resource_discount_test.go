@@ -0,0 +1,414 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/polarsource/polar-go/models/components"
+)
+
+func TestAccDiscountResource_percentage(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	code := fmt.Sprintf("TFACC%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDiscountPercentageConfig(rName, code, 2500, 10),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_discount.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(rName),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_discount.test",
+						tfjsonpath.New("basis_points"),
+						knownvalue.Int64Exact(2500),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_discount.test",
+						tfjsonpath.New("amount"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_discount.test",
+						tfjsonpath.New("redemptions_count"),
+						knownvalue.Int64Exact(0),
+					),
+				},
+			},
+			// ImportState
+			{
+				ResourceName:      "polar_discount.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update name and redemption limit
+			{
+				Config: testAccDiscountPercentageConfig(rName+"-updated", code, 2500, 20),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_discount.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(rName+"-updated"),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_discount.test",
+						tfjsonpath.New("max_redemptions"),
+						knownvalue.Int64Exact(20),
+					),
+				},
+			},
+			// Remove code and redemption limit
+			{
+				Config: testAccDiscountPercentageMinimalConfig(rName+"-updated", 2500),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_discount.test",
+						tfjsonpath.New("code"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_discount.test",
+						tfjsonpath.New("max_redemptions"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDiscountResource_fixedRepeating(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDiscountFixedRepeatingConfig(rName, 500, 3),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_discount.test",
+						tfjsonpath.New("amount"),
+						knownvalue.Int64Exact(500),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_discount.test",
+						tfjsonpath.New("currency"),
+						knownvalue.StringExact("usd"),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_discount.test",
+						tfjsonpath.New("duration_in_months"),
+						knownvalue.Int64Exact(3),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_discount.test",
+						tfjsonpath.New("product_ids"),
+						knownvalue.SetSizeExact(1),
+					),
+				},
+			},
+			{
+				ResourceName:      "polar_discount.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Empty the product restriction. product_ids is read back from the
+			// API, so an empty set proves it was cleared server-side.
+			{
+				Config: testAccDiscountFixedRepeatingProductsConfig(rName, 500, 3, "[]"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_discount.test",
+						tfjsonpath.New("product_ids"),
+						knownvalue.SetSizeExact(0),
+					),
+				},
+			},
+			// Restrict again, then remove product_ids entirely.
+			{
+				Config: testAccDiscountFixedRepeatingConfig(rName, 500, 3),
+			},
+			{
+				Config: testAccDiscountFixedRepeatingProductsConfig(rName, 500, 3, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_discount.test",
+						tfjsonpath.New("product_ids"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestDiscountResource_typeValidation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "polar_discount" "test" {
+  name     = "Test"
+  type     = "percentage"
+  duration = "once"
+}
+`,
+				ExpectError: regexp.MustCompile(`basis_points is required when type is "percentage"`),
+			},
+			{
+				Config: `
+resource "polar_discount" "test" {
+  name     = "Test"
+  type     = "fixed"
+  amount   = 500
+  duration = "forever"
+
+  duration_in_months = 3
+}
+`,
+				ExpectError: regexp.MustCompile(`duration_in_months is not used when duration is "forever"`),
+			},
+			{
+				Config: `
+resource "polar_discount" "test" {
+  name         = "Test"
+  type         = "percentage"
+  basis_points = 1000
+  duration     = "once"
+  starts_at    = "2030-01-02T00:00:00Z"
+  ends_at      = "2030-01-01T00:00:00Z"
+}
+`,
+				ExpectError: regexp.MustCompile(`must be after starts_at`),
+			},
+		},
+	})
+}
+
+func TestMapDiscountResponseToState_fixedRepeat(t *testing.T) {
+	ctx := context.Background()
+	startsAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxRedemptions := int64(100)
+	discount := components.CreateDiscountDiscountFixedRepeatDuration(components.DiscountFixedRepeatDuration{
+		ID:               "disc_123",
+		Name:             "Launch",
+		Type:             components.DiscountTypeFixed,
+		Duration:         components.DiscountDurationRepeating,
+		DurationInMonths: 3,
+		Amount:           500,
+		Currency:         "usd",
+		StartsAt:         &startsAt,
+		MaxRedemptions:   &maxRedemptions,
+		RedemptionsCount: 7,
+		Metadata:         map[string]components.DiscountFixedRepeatDurationMetadata{},
+		Products:         []components.DiscountProduct{{ID: "prod_1"}},
+	})
+
+	// Stale values from a previous percentage config must be cleared.
+	data := DiscountResourceModel{
+		BasisPoints: types.Int64Value(2500),
+		ProductIDs:  types.SetNull(types.StringType),
+	}
+	var diags diag.Diagnostics
+	mapDiscountResponseToState(ctx, &discount, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := data.Type.ValueString(); got != "fixed" {
+		t.Errorf("type = %q, want %q", got, "fixed")
+	}
+	if !data.BasisPoints.IsNull() {
+		t.Errorf("basis_points = %s, want null", data.BasisPoints)
+	}
+	if got := data.Amount.ValueInt64(); got != 500 {
+		t.Errorf("amount = %d, want 500", got)
+	}
+	if got := data.DurationInMonths.ValueInt64(); got != 3 {
+		t.Errorf("duration_in_months = %d, want 3", got)
+	}
+	if got := data.StartsAt.ValueString(); got != "2030-01-01T00:00:00Z" {
+		t.Errorf("starts_at = %q, want %q", got, "2030-01-01T00:00:00Z")
+	}
+	if !data.EndsAt.IsNull() {
+		t.Errorf("ends_at = %s, want null", data.EndsAt)
+	}
+	if got := data.RedemptionsCount.ValueInt64(); got != 7 {
+		t.Errorf("redemptions_count = %d, want 7", got)
+	}
+	// product_ids stays null when the config did not restrict products.
+	if !data.ProductIDs.IsNull() {
+		t.Errorf("product_ids = %s, want null", data.ProductIDs)
+	}
+}
+
+func TestBuildDiscountClearPayload(t *testing.T) {
+	productIDs := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("prod_1")})
+	metadata := types.MapValueMust(types.StringType, map[string]attr.Value{"tier": types.StringValue("pro")})
+	prior := DiscountResourceModel{
+		Code:           types.StringValue("LAUNCH"),
+		StartsAt:       types.StringValue("2030-01-01T00:00:00Z"),
+		EndsAt:         types.StringValue("2030-02-01T00:00:00Z"),
+		MaxRedemptions: types.Int64Value(10),
+		ProductIDs:     productIDs,
+		Metadata:       metadata,
+	}
+
+	tests := []struct {
+		name string
+		plan DiscountResourceModel
+		want map[string]any
+	}{
+		{
+			name: "unchanged",
+			plan: prior,
+			want: map[string]any{},
+		},
+		{
+			name: "removed",
+			plan: DiscountResourceModel{
+				Code:           types.StringNull(),
+				StartsAt:       types.StringNull(),
+				EndsAt:         types.StringNull(),
+				MaxRedemptions: types.Int64Null(),
+				ProductIDs:     types.SetNull(types.StringType),
+				Metadata:       types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			},
+			want: map[string]any{
+				"code":            nil,
+				"starts_at":       nil,
+				"ends_at":         nil,
+				"max_redemptions": nil,
+				"products":        []string{},
+				"metadata":        map[string]any{},
+			},
+		},
+		{
+			// Metadata is computed: removing it from config plans it unknown
+			// (or keeps the prior value), which leaves it alone.
+			name: "metadata unknown",
+			plan: DiscountResourceModel{
+				Code:           prior.Code,
+				StartsAt:       prior.StartsAt,
+				EndsAt:         prior.EndsAt,
+				MaxRedemptions: prior.MaxRedemptions,
+				ProductIDs:     types.SetValueMust(types.StringType, []attr.Value{}),
+				Metadata:       types.MapUnknown(types.StringType),
+			},
+			want: map[string]any{"products": []string{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildDiscountClearPayload(&tt.plan, &prior)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildDiscountClearPayload() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPreserveTimestampFormatting(t *testing.T) {
+	tests := []struct {
+		name    string
+		current types.String
+		prior   types.String
+		want    string
+	}{
+		{"equivalent offset keeps prior", types.StringValue("2030-01-01T00:00:00Z"), types.StringValue("2030-01-01T01:00:00+01:00"), "2030-01-01T01:00:00+01:00"},
+		{"different instant takes current", types.StringValue("2030-01-02T00:00:00Z"), types.StringValue("2030-01-01T00:00:00Z"), "2030-01-02T00:00:00Z"},
+		{"null prior takes current", types.StringValue("2030-01-01T00:00:00Z"), types.StringNull(), "2030-01-01T00:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := tt.current
+			preserveTimestampFormatting(&current, tt.prior)
+			if got := current.ValueString(); got != tt.want {
+				t.Errorf("preserveTimestampFormatting() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// --- Config helpers ---
+
+func testAccDiscountPercentageConfig(name, code string, basisPoints, maxRedemptions int64) string {
+	return fmt.Sprintf(`
+resource "polar_discount" "test" {
+  name            = %q
+  type            = "percentage"
+  basis_points    = %d
+  duration        = "once"
+  code            = %q
+  max_redemptions = %d
+}
+`, name, basisPoints, code, maxRedemptions)
+}
+
+func testAccDiscountPercentageMinimalConfig(name string, basisPoints int64) string {
+	return fmt.Sprintf(`
+resource "polar_discount" "test" {
+  name         = %q
+  type         = "percentage"
+  basis_points = %d
+  duration     = "once"
+}
+`, name, basisPoints)
+}
+
+func testAccDiscountFixedRepeatingConfig(name string, amount, months int64) string {
+	return testAccDiscountFixedRepeatingProductsConfig(name, amount, months, "[polar_product.test.id]")
+}
+
+// testAccDiscountFixedRepeatingProductsConfig sets product_ids to the given
+// HCL expression, or omits it when productIDs is empty.
+func testAccDiscountFixedRepeatingProductsConfig(name string, amount, months int64, productIDs string) string {
+	products := ""
+	if productIDs != "" {
+		products = "product_ids        = " + productIDs
+	}
+	return fmt.Sprintf(`
+resource "polar_product" "test" {
+  name = %[1]q
+
+  prices = [{
+    amount_type  = "fixed"
+    price_amount = 1000
+  }]
+}
+
+resource "polar_discount" "test" {
+  name               = %[1]q
+  type               = "fixed"
+  amount             = %[2]d
+  currency           = "usd"
+  duration           = "repeating"
+  duration_in_months = %[3]d
+  %[4]s
+}
+`, name, amount, months, products)
+}
@@ -6,13 +6,16 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -31,30 +34,63 @@ func NewMeterResource() resource.Resource {
 }
 
 type MeterResource struct {
-	client *polargo.Polar
+	client         *polargo.Polar
+	organizationID string            // provider default, see resolveOrganizationID
+	consistency    consistencyPolicy // provider consistency settings, see pollForConsistency
+	retry          retryPolicy       // provider retry settings, see retryPolicy.createOption
 }
 
 // --- Terraform model types (shared between resource and data source) ---
 
 type MeterResourceModel struct {
-	ID          types.String      `tfsdk:"id"`
-	Name        types.String      `tfsdk:"name"`
-	Filter      *FilterModel      `tfsdk:"filter"`
-	Aggregation *AggregationModel `tfsdk:"aggregation"`
-	Metadata    types.Map         `tfsdk:"metadata"`
+	ID             types.String      `tfsdk:"id"`
+	OrganizationID types.String      `tfsdk:"organization_id"`
+	Name           types.String      `tfsdk:"name"`
+	Filter         *FilterModel      `tfsdk:"filter"`
+	Aggregation    *AggregationModel `tfsdk:"aggregation"`
+	Metadata       types.Map         `tfsdk:"metadata"`
+}
+
+// meterResourceData is the polar_meter resource's state: MeterResourceModel, which
+// the meter data sources share, plus resource-only settings.
+type meterResourceData struct {
+	MeterResourceModel
+	Consistency *ConsistencyOverrideModel `tfsdk:"consistency"`
 }
 
 // FilterModel defines which incoming events the meter counts.
-// Clauses are combined with the conjunction (and/or).
+// Clauses and groups are combined with the conjunction (and/or).
+//
+// The API allows arbitrarily deep nesting, but Terraform schemas can't be
+// recursive, so nesting is unrolled into fixed levels: filter → groups →
+// groups. That covers expressions like ((a AND b) OR c) AND d.
 type FilterModel struct {
 	Conjunction types.String        `tfsdk:"conjunction"`
 	Clauses     []FilterClauseModel `tfsdk:"clauses"`
+	Groups      []FilterGroupModel  `tfsdk:"groups"`
+}
+
+// FilterGroupModel is a parenthesized sub-filter of the top-level filter.
+type FilterGroupModel struct {
+	Conjunction types.String          `tfsdk:"conjunction"`
+	Clauses     []FilterClauseModel   `tfsdk:"clauses"`
+	Groups      []FilterSubgroupModel `tfsdk:"groups"`
+}
+
+// FilterSubgroupModel is the innermost nesting level; it holds clauses only.
+type FilterSubgroupModel struct {
+	Conjunction types.String        `tfsdk:"conjunction"`
+	Clauses     []FilterClauseModel `tfsdk:"clauses"`
 }
 
+// FilterClauseModel is a single property comparison. Value is always written
+// as a string in config; ValueType selects the JSON type sent to the API so
+// numeric and boolean comparisons aren't done on strings server-side.
 type FilterClauseModel struct {
-	Property types.String `tfsdk:"property"`
-	Operator types.String `tfsdk:"operator"`
-	Value    types.String `tfsdk:"value"`
+	Property  types.String `tfsdk:"property"`
+	Operator  types.String `tfsdk:"operator"`
+	Value     types.String `tfsdk:"value"`
+	ValueType types.String `tfsdk:"value_type"`
 }
 
 // AggregationModel defines how matched events are aggregated (count, sum, avg, etc.).
@@ -79,6 +115,7 @@ func (r *MeterResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"organization_id": organizationIDAttribute("meter"),
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the meter, shown on invoices and usage reports.",
 				Required:            true,
@@ -86,38 +123,29 @@ func (r *MeterResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"filter": schema.SingleNestedAttribute{
 				MarkdownDescription: "Filter to apply on incoming events.",
 				Required:            true,
-				Attributes: map[string]schema.Attribute{
-					"conjunction": schema.StringAttribute{
-						MarkdownDescription: "Logical conjunction for combining clauses. Must be `and` or `or`.",
-						Required:            true,
-						Validators: []validator.String{
-							stringvalidator.OneOf("and", "or"),
+				Attributes: filterAttributes(map[string]schema.Attribute{
+					"groups": schema.ListNestedAttribute{
+						MarkdownDescription: "Nested clause groups, each combined with the top-level clauses using `conjunction`.",
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
 						},
-					},
-					"clauses": schema.ListNestedAttribute{
-						MarkdownDescription: "List of filter clauses.",
-						Required:            true,
 						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"property": schema.StringAttribute{
-									MarkdownDescription: "The event property to filter on.",
-									Required:            true,
-								},
-								"operator": schema.StringAttribute{
-									MarkdownDescription: "The comparison operator. Must be one of: `eq`, `ne`, `gt`, `gte`, `lt`, `lte`, `like`, `not_like`.",
-									Required:            true,
-									Validators: []validator.String{
-										stringvalidator.OneOf("eq", "ne", "gt", "gte", "lt", "lte", "like", "not_like"),
+							Attributes: filterAttributes(map[string]schema.Attribute{
+								"groups": schema.ListNestedAttribute{
+									MarkdownDescription: "Nested clause groups, each combined with this group's clauses using its `conjunction`. This is the deepest supported nesting level.",
+									Optional:            true,
+									Validators: []validator.List{
+										listvalidator.SizeAtLeast(1),
+									},
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: filterAttributes(nil),
 									},
 								},
-								"value": schema.StringAttribute{
-									MarkdownDescription: "The value to compare against.",
-									Required:            true,
-								},
-							},
+							}),
 						},
 					},
-				},
+				}),
 			},
 			"aggregation": schema.SingleNestedAttribute{
 				MarkdownDescription: "Aggregation function for the meter.",
@@ -142,12 +170,65 @@ func (r *MeterResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"consistency": consistencyOverrideAttribute(),
+		},
+	}
+}
+
+// filterAttributes returns the conjunction and clauses attributes shared by
+// every filter nesting level, merged with any level-specific extras.
+func filterAttributes(extra map[string]schema.Attribute) map[string]schema.Attribute {
+	attrs := map[string]schema.Attribute{
+		"conjunction": schema.StringAttribute{
+			MarkdownDescription: "Logical conjunction for combining clauses. Must be `and` or `or`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("and", "or"),
+			},
+		},
+		"clauses": schema.ListNestedAttribute{
+			MarkdownDescription: "List of filter clauses.",
+			Required:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"property": schema.StringAttribute{
+						MarkdownDescription: "The event property to filter on.",
+						Required:            true,
+					},
+					"operator": schema.StringAttribute{
+						MarkdownDescription: "The comparison operator. Must be one of: `eq`, `ne`, `gt`, `gte`, `lt`, `lte`, `like`, `not_like`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("eq", "ne", "gt", "gte", "lt", "lte", "like", "not_like"),
+						},
+					},
+					"value": schema.StringAttribute{
+						MarkdownDescription: "The value to compare against, written as a string (e.g. `\"1000\"` or `\"true\"`) and converted according to `value_type`.",
+						Required:            true,
+					},
+					"value_type": schema.StringAttribute{
+						MarkdownDescription: "How `value` is sent to the API. Must be one of: `string`, `integer`, `boolean`. Use `integer` for numeric comparisons such as `gt` or `lte`. Defaults to `string`. " +
+							"Decimal values such as `\"0.5\"` are not supported, since the Polar Go SDK (v0.12.0) has no decimal filter value; " +
+							"they are rejected unless `value_type` is explicitly `string`, which compares them as text.",
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("string"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("string", "integer", "boolean"),
+						},
+					},
+				},
+			},
 		},
 	}
+	for k, v := range extra {
+		attrs[k] = v
+	}
+	return attrs
 }
 
 func (r *MeterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data MeterResourceModel
+	var data meterResourceData
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -155,6 +236,18 @@ func (r *MeterResource) ValidateConfig(ctx context.Context, req resource.Validat
 
 	validateMetadata(ctx, data.Metadata, &resp.Diagnostics)
 
+	if data.Filter != nil {
+		filterPath := path.Root("filter")
+		validateFilterClauses(data.Filter.Clauses, filterPath.AtName("clauses"), &resp.Diagnostics)
+		for i, g := range data.Filter.Groups {
+			groupPath := filterPath.AtName("groups").AtListIndex(i)
+			validateFilterClauses(g.Clauses, groupPath.AtName("clauses"), &resp.Diagnostics)
+			for j, sg := range g.Groups {
+				validateFilterClauses(sg.Clauses, groupPath.AtName("groups").AtListIndex(j).AtName("clauses"), &resp.Diagnostics)
+			}
+		}
+	}
+
 	if data.Aggregation == nil || data.Aggregation.Func.IsUnknown() {
 		return
 	}
@@ -178,25 +271,67 @@ func (r *MeterResource) ValidateConfig(ctx context.Context, req resource.Validat
 	}
 }
 
+// validateFilterClauses checks that each clause value parses as its value_type.
+// Only canonical forms are accepted (e.g. "123", not "0123" or "+123"), since
+// the API echoes typed values back in canonical form and anything else would
+// show up as drift on the next plan. Decimals are rejected unless value_type
+// is explicitly "string": polar-go v0.12.0 has no float filter value, so the
+// default would silently compare them as strings.
+func validateFilterClauses(clauses []FilterClauseModel, clausesPath path.Path, diags *diag.Diagnostics) {
+	for i, c := range clauses {
+		if c.Value.IsUnknown() || c.Value.IsNull() || c.ValueType.IsUnknown() {
+			continue
+		}
+		if c.ValueType.IsNull() && isDecimal(c.Value.ValueString()) {
+			diags.AddAttributeError(
+				clausesPath.AtListIndex(i).AtName("value"),
+				"Unsupported decimal filter value",
+				fmt.Sprintf("The value for property %q is a decimal (%q), but filters can't compare decimals yet: "+
+					"it would be compared as a string. Compare against an integer with value_type = \"integer\", "+
+					"or set value_type = \"string\" to compare it as text.", c.Property.ValueString(), c.Value.ValueString()),
+			)
+			continue
+		}
+		if _, err := filterClauseValueToSDK(c); err != nil {
+			diags.AddAttributeError(
+				clausesPath.AtListIndex(i).AtName("value"),
+				"Invalid filter value",
+				filterValueErrorDetail(c, err),
+			)
+		}
+	}
+}
+
 func (r *MeterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
 		r.client = pd.Client
+		r.consistency = pd.Consistency
+		r.retry = pd.Retry
+		r.organizationID = pd.OrganizationID
 	}
 }
 
 // Create: plan → convert to SDK types → call API → poll for consistency → save state.
 func (r *MeterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data MeterResourceModel
+	ctx = withCorrelationID(ctx)
+	var data meterResourceData
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	filter := filterModelToSDK(data.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build the SDK create request from TF model.
+	data.OrganizationID = resolveOrganizationID(data.OrganizationID, r.organizationID)
 	createReq := components.MeterCreate{
-		Name:        data.Name.ValueString(),
-		Filter:      filterModelToSDK(data.Filter),
-		Aggregation: aggregationModelToCreateSDK(data.Aggregation),
+		Name:           data.Name.ValueString(),
+		Filter:         filter,
+		Aggregation:    aggregationModelToCreateSDK(data.Aggregation),
+		OrganizationID: optionalStringPointer(data.OrganizationID),
 	}
 
 	// Metadata is optional — only include if user specified it.
@@ -209,7 +344,7 @@ func (r *MeterResource) Create(ctx context.Context, req resource.CreateRequest,
 		createReq.Metadata = m
 	}
 
-	result, err := r.client.Meters.Create(ctx, createReq)
+	result, err := r.client.Meters.Create(ctx, createReq, r.retry.createOption())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating meter",
@@ -224,7 +359,7 @@ func (r *MeterResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Eventual consistency poll — wait for GET to reflect the write.
 	writeTime := latestTimestamp(result.Meter)
-	meter, err := pollForConsistency(ctx, "meter", result.Meter.ID, writeTime, func() (*components.Meter, error) {
+	meter, err := pollForConsistency(ctx, r.consistency.withOverride(data.Consistency), "meter", result.Meter.ID, writeTime, func() (*components.Meter, error) {
 		r, err := r.client.Meters.Get(ctx, result.Meter.ID)
 		if err != nil {
 			return nil, err
@@ -239,7 +374,7 @@ func (r *MeterResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	mapMeterResponseToState(ctx, meter, &data, &resp.Diagnostics)
+	mapMeterResponseToState(ctx, meter, &data.MeterResourceModel, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -247,7 +382,8 @@ func (r *MeterResource) Create(ctx context.Context, req resource.CreateRequest,
 // - 404 Not Found → resource deleted out-of-band
 // - ArchivedAt set → resource was archived (our Delete archives, not deletes).
 func (r *MeterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data MeterResourceModel
+	ctx = withCorrelationID(ctx)
+	var data meterResourceData
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -274,20 +410,25 @@ func (r *MeterResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	mapMeterResponseToState(ctx, result.Meter, &data, &resp.Diagnostics)
+	mapMeterResponseToState(ctx, result.Meter, &data.MeterResourceModel, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Update: plan → build SDK request → call API → poll for consistency → save state.
 func (r *MeterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data MeterResourceModel
+	ctx = withCorrelationID(ctx)
+	var data meterResourceData
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	filter := filterModelToSDK(data.Filter, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	name := data.Name.ValueString()
-	filter := filterModelToSDK(data.Filter)
 	aggregation := aggregationModelToUpdateSDK(data.Aggregation)
 
 	updateReq := components.MeterUpdate{
@@ -316,7 +457,7 @@ func (r *MeterResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Eventual consistency poll.
 	writeTime := latestTimestamp(result.Meter)
-	meter, err := pollForConsistency(ctx, "meter", result.Meter.ID, writeTime, func() (*components.Meter, error) {
+	meter, err := pollForConsistency(ctx, r.consistency.withOverride(data.Consistency), "meter", result.Meter.ID, writeTime, func() (*components.Meter, error) {
 		r, err := r.client.Meters.Get(ctx, result.Meter.ID)
 		if err != nil {
 			return nil, err
@@ -331,14 +472,15 @@ func (r *MeterResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	mapMeterResponseToState(ctx, meter, &data, &resp.Diagnostics)
+	mapMeterResponseToState(ctx, meter, &data.MeterResourceModel, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Delete archives the meter (Polar has no DELETE for meters).
 // Archived meters are treated as "gone" by Read.
 func (r *MeterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data MeterResourceModel
+	ctx = withCorrelationID(ctx)
+	var data meterResourceData
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -364,13 +506,22 @@ func (r *MeterResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	})
 }
 
+// ImportState accepts an ID, "name:<name>" or "metadata:<key>=<value>".
 func (r *MeterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ctx = withCorrelationID(ctx)
+	importStateByLookup(ctx, req, resp, func(lookup objectLookup, diags *diag.Diagnostics) string {
+		organizationID := resolveOrganizationID(types.StringNull(), r.organizationID)
+		if m := findMeter(ctx, r.client, lookup, organizationID, diags); m != nil {
+			return m.ID
+		}
+		return ""
+	})
 }
 
 // mapMeterResponseToState maps a Meter API response to the Terraform resource model.
 func mapMeterResponseToState(ctx context.Context, meter *components.Meter, data *MeterResourceModel, diags *diag.Diagnostics) {
 	data.ID = types.StringValue(meter.ID)
+	data.OrganizationID = types.StringValue(meter.OrganizationID)
 	data.Name = types.StringValue(meter.Name)
 	data.Filter = sdkFilterToModel(meter.Filter, diags)
 	data.Aggregation = sdkAggregationToModel(meter.Aggregation, diags)
@@ -386,57 +537,167 @@ func mapMeterResponseToState(ctx context.Context, meter *components.Meter, data
 // while TF uses flat structs, so the conversions involve switch statements.
 
 // filterModelToSDK converts the TF filter model → SDK Filter for create/update requests.
-func filterModelToSDK(filter *FilterModel) components.Filter {
-	clauses := make([]components.Clauses, len(filter.Clauses))
-	for i, c := range filter.Clauses {
-		clauses[i] = components.CreateClausesFilterClause(
+// Flat clauses are sent first, followed by nested groups; order within a
+// conjunction doesn't affect which events match.
+func filterModelToSDK(filter *FilterModel, diags *diag.Diagnostics) components.Filter {
+	clauses := filterClausesToSDK(filter.Clauses, diags)
+	for _, g := range filter.Groups {
+		clauses = append(clauses, components.CreateClausesFilter(filterGroupToSDK(g, diags)))
+	}
+	return components.Filter{
+		Conjunction: components.FilterConjunction(filter.Conjunction.ValueString()),
+		Clauses:     clauses,
+	}
+}
+
+func filterGroupToSDK(group FilterGroupModel, diags *diag.Diagnostics) components.Filter {
+	clauses := filterClausesToSDK(group.Clauses, diags)
+	for _, sg := range group.Groups {
+		clauses = append(clauses, components.CreateClausesFilter(components.Filter{
+			Conjunction: components.FilterConjunction(sg.Conjunction.ValueString()),
+			Clauses:     filterClausesToSDK(sg.Clauses, diags),
+		}))
+	}
+	return components.Filter{
+		Conjunction: components.FilterConjunction(group.Conjunction.ValueString()),
+		Clauses:     clauses,
+	}
+}
+
+func filterClausesToSDK(clauses []FilterClauseModel, diags *diag.Diagnostics) []components.Clauses {
+	result := make([]components.Clauses, len(clauses))
+	for i, c := range clauses {
+		// ValidateConfig catches bad values up front; this only fires for
+		// values that were unknown at plan time.
+		value, err := filterClauseValueToSDK(c)
+		if err != nil {
+			diags.AddError("Invalid filter value", filterValueErrorDetail(c, err))
+		}
+		result[i] = components.CreateClausesFilterClause(
 			components.FilterClause{
 				Property: c.Property.ValueString(),
 				Operator: components.FilterOperator(c.Operator.ValueString()),
-				Value:    components.CreateValueStr(c.Value.ValueString()),
+				Value:    value,
 			},
 		)
 	}
-	return components.Filter{
-		Conjunction: components.FilterConjunction(filter.Conjunction.ValueString()),
-		Clauses:     clauses,
+	return result
+}
+
+// isDecimal reports whether raw is a number with a fractional part or
+// exponent, such as "0.5" or "1e3", rather than an integer.
+func isDecimal(raw string) bool {
+	if _, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		return false
 	}
+	_, err := strconv.ParseFloat(raw, 64)
+	return err == nil && strings.ContainsAny(raw, ".eE")
 }
 
+func filterValueErrorDetail(c FilterClauseModel, err error) string {
+	return fmt.Sprintf("The value for property %q does not match value_type %q: %s.", c.Property.ValueString(), c.ValueType.ValueString(), err)
+}
+
+// filterClauseValueToSDK converts a clause's string value to the SDK value
+// union variant selected by value_type. A null value_type means "string".
+func filterClauseValueToSDK(c FilterClauseModel) (components.Value, error) {
+	raw := c.Value.ValueString()
+	switch c.ValueType.ValueString() {
+	case "integer":
+		v, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || strconv.FormatInt(v, 10) != raw {
+			return components.Value{}, fmt.Errorf("%q is not an integer in canonical form (no leading zeros or plus sign, e.g. %q)", raw, "1000")
+		}
+		return components.CreateValueInteger(v), nil
+	case "boolean":
+		if raw != "true" && raw != "false" {
+			return components.Value{}, fmt.Errorf("%q is not a boolean (use %q or %q)", raw, "true", "false")
+		}
+		return components.CreateValueBoolean(raw == "true"), nil
+	default:
+		return components.CreateValueStr(raw), nil
+	}
+}
+
+// maxFilterDepth is the number of filter levels the schema can represent:
+// the top-level filter, its groups, and their groups.
+const maxFilterDepth = 3
+
 // sdkFilterToModel converts an SDK Filter → TF model for state mapping.
-// Only handles FilterClause (flat); nested Filter clauses are skipped since
-// the TF schema doesn't support recursive nesting.
+// Nested filters map to groups (and groups of groups). Anything nested deeper
+// than the schema supports is dropped with a warning rather than silently.
 func sdkFilterToModel(filter components.Filter, diags *diag.Diagnostics) *FilterModel {
-	clauses := make([]FilterClauseModel, 0, len(filter.Clauses))
-	for _, c := range filter.Clauses {
-		if c.FilterClause != nil {
-			clause := c.FilterClause
-			var value string
-			switch {
-			case clause.Value.Str != nil:
-				value = *clause.Value.Str
-			case clause.Value.Integer != nil:
-				value = strconv.FormatInt(*clause.Value.Integer, 10)
-			case clause.Value.Boolean != nil:
-				value = strconv.FormatBool(*clause.Value.Boolean)
-			default:
-				diags.AddWarning(
-					"Unknown filter clause value type",
-					fmt.Sprintf("Filter clause for property %q has an unrecognized value type. The value was set to empty.", clause.Property),
-				)
-			}
-			clauses = append(clauses, FilterClauseModel{
-				Property: types.StringValue(clause.Property),
-				Operator: types.StringValue(string(clause.Operator)),
-				Value:    types.StringValue(value),
-			})
-		}
-		// Skip nested Filter clauses (not supported in flat TF schema)
+	clauses, nested := splitSDKClauses(filter.Clauses, diags)
+	model := &FilterModel{
+		Conjunction: types.StringValue(string(filter.Conjunction)),
+		Clauses:     clauses,
+	}
+	// Groups stay nil (null) unless the API returned nested filters, matching
+	// configs that omit the optional attribute.
+	for _, f := range nested {
+		model.Groups = append(model.Groups, sdkFilterGroupToModel(f, diags))
 	}
-	return &FilterModel{
+	return model
+}
+
+func sdkFilterGroupToModel(filter components.Filter, diags *diag.Diagnostics) FilterGroupModel {
+	clauses, nested := splitSDKClauses(filter.Clauses, diags)
+	group := FilterGroupModel{
 		Conjunction: types.StringValue(string(filter.Conjunction)),
 		Clauses:     clauses,
 	}
+	for _, f := range nested {
+		subClauses, deeper := splitSDKClauses(f.Clauses, diags)
+		if len(deeper) > 0 {
+			diags.AddWarning(
+				"Filter nesting too deep",
+				fmt.Sprintf("The meter filter nests groups more than %d levels deep, which this provider version can't represent. The deeper groups were omitted from state; applying this configuration will remove them.", maxFilterDepth),
+			)
+		}
+		group.Groups = append(group.Groups, FilterSubgroupModel{
+			Conjunction: types.StringValue(string(f.Conjunction)),
+			Clauses:     subClauses,
+		})
+	}
+	return group
+}
+
+// splitSDKClauses separates flat clauses (converted to TF models) from
+// nested filters, which the caller maps to the next nesting level.
+func splitSDKClauses(sdkClauses []components.Clauses, diags *diag.Diagnostics) ([]FilterClauseModel, []components.Filter) {
+	clauses := make([]FilterClauseModel, 0, len(sdkClauses))
+	var nested []components.Filter
+	for _, c := range sdkClauses {
+		if c.Filter != nil {
+			nested = append(nested, *c.Filter)
+			continue
+		}
+		if c.FilterClause == nil {
+			continue
+		}
+		clause := c.FilterClause
+		value, valueType := "", "string"
+		switch {
+		case clause.Value.Str != nil:
+			value = *clause.Value.Str
+		case clause.Value.Integer != nil:
+			value, valueType = strconv.FormatInt(*clause.Value.Integer, 10), "integer"
+		case clause.Value.Boolean != nil:
+			value, valueType = strconv.FormatBool(*clause.Value.Boolean), "boolean"
+		default:
+			diags.AddWarning(
+				"Unknown filter clause value type",
+				fmt.Sprintf("Filter clause for property %q has an unrecognized value type. The value was set to empty.", clause.Property),
+			)
+		}
+		clauses = append(clauses, FilterClauseModel{
+			Property:  types.StringValue(clause.Property),
+			Operator:  types.StringValue(string(clause.Operator)),
+			Value:     types.StringValue(value),
+			ValueType: types.StringValue(valueType),
+		})
+	}
+	return clauses, nested
 }
 
 // aggregationModelToCreateSDK converts the TF aggregation → SDK union type for create.
@@ -3,16 +3,27 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/polarsource/polar-go/models/components"
+	"github.com/sjkchang/terraform-provider-polar/internal/polartest"
 )
 
 func TestAccMeterResource_count(t *testing.T) {
@@ -122,8 +133,85 @@ func TestAccMeterResource_metadata(t *testing.T) {
 	})
 }
 
+func TestAccMeterResource_nestedFilter(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeterNestedFilterConfig(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_meter.test",
+						tfjsonpath.New("filter").AtMapKey("groups").AtSliceIndex(0).AtMapKey("conjunction"),
+						knownvalue.StringExact("and"),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_meter.test",
+						tfjsonpath.New("filter").AtMapKey("groups").AtSliceIndex(0).AtMapKey("clauses"),
+						knownvalue.ListSizeExact(2),
+					),
+				},
+			},
+			// ImportState must preserve the nesting.
+			{
+				ResourceName:      "polar_meter.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMeterResource_typedFilterValue(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeterTypedFilterConfig(rName, "1000"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_meter.test",
+						tfjsonpath.New("filter").AtMapKey("clauses").AtSliceIndex(0).AtMapKey("value_type"),
+						knownvalue.StringExact("integer"),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_meter.test",
+						tfjsonpath.New("filter").AtMapKey("clauses").AtSliceIndex(1).AtMapKey("value_type"),
+						knownvalue.StringExact("string"),
+					),
+				},
+			},
+			{
+				ResourceName:      "polar_meter.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestMeterResource_filterValueValidation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMeterTypedFilterConfig("test", "01000"),
+				ExpectError: regexp.MustCompile(`does not match value_type "integer"`),
+			},
+			{
+				Config:      testAccMeterTypedFilterConfig("test", "lots"),
+				ExpectError: regexp.MustCompile(`does not match value_type "integer"`),
+			},
+		},
+	})
+}
+
 func TestSdkFilterToModel_valueTypeCoercion(t *testing.T) {
-	// Filter values are always sent as strings (CreateValueStr). If the API
+	// Untyped (value_type = "string") values are sent as strings. If the API
 	// returns them as a different union variant, verify the string conversion
 	// roundtrips correctly.
 	tests := []struct {
@@ -213,6 +301,378 @@ func TestSdkFilterToModel_valueTypeDrift(t *testing.T) {
 	}
 }
 
+func TestFilterModelToSDK_nestedRoundTrip(t *testing.T) {
+	// ((plan eq pro AND region eq eu) OR tier eq enterprise) AND name eq api_call
+	model := &FilterModel{
+		Conjunction: types.StringValue("and"),
+		Clauses:     []FilterClauseModel{testFilterClause("name", "api_call")},
+		Groups: []FilterGroupModel{{
+			Conjunction: types.StringValue("or"),
+			Clauses:     []FilterClauseModel{testFilterClause("tier", "enterprise")},
+			Groups: []FilterSubgroupModel{{
+				Conjunction: types.StringValue("and"),
+				Clauses: []FilterClauseModel{
+					testFilterClause("plan", "pro"),
+					testFilterClause("region", "eu"),
+				},
+			}},
+		}},
+	}
+
+	var diags diag.Diagnostics
+	sdk := filterModelToSDK(model, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(sdk.Clauses) != 2 || sdk.Clauses[1].Filter == nil {
+		t.Fatalf("expected a flat clause followed by a nested filter, got %+v", sdk.Clauses)
+	}
+	if inner := sdk.Clauses[1].Filter.Clauses; len(inner) != 2 || inner[1].Filter == nil {
+		t.Fatalf("expected the group to contain a nested subgroup, got %+v", inner)
+	}
+
+	got := sdkFilterToModel(sdk, &diags)
+	if diags.HasError() || diags.WarningsCount() > 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !reflect.DeepEqual(got, model) {
+		t.Errorf("round trip mismatch:\ngot  %+v\nwant %+v", got, model)
+	}
+}
+
+func TestSdkFilterToModel_nestedFromAPI(t *testing.T) {
+	// (a AND b) OR c as built in the dashboard: the group comes first.
+	filter := components.Filter{
+		Conjunction: "or",
+		Clauses: []components.Clauses{
+			components.CreateClausesFilter(components.Filter{
+				Conjunction: "and",
+				Clauses: []components.Clauses{
+					testSDKFilterClause("a", "1"),
+					testSDKFilterClause("b", "2"),
+				},
+			}),
+			testSDKFilterClause("c", "3"),
+		},
+	}
+
+	var diags diag.Diagnostics
+	got := sdkFilterToModel(filter, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(got.Clauses) != 1 || got.Clauses[0].Property.ValueString() != "c" {
+		t.Errorf("clauses = %+v, want only c", got.Clauses)
+	}
+	if len(got.Groups) != 1 {
+		t.Fatalf("groups = %d, want 1", len(got.Groups))
+	}
+	if got.Groups[0].Conjunction.ValueString() != "and" || len(got.Groups[0].Clauses) != 2 {
+		t.Errorf("group = %+v, want and(a, b)", got.Groups[0])
+	}
+	if got.Groups[0].Groups != nil {
+		t.Errorf("group.groups = %+v, want nil", got.Groups[0].Groups)
+	}
+}
+
+func TestSdkFilterToModel_flatLeavesGroupsNull(t *testing.T) {
+	filter := components.Filter{
+		Conjunction: "and",
+		Clauses:     []components.Clauses{testSDKFilterClause("name", "api_call")},
+	}
+
+	var diags diag.Diagnostics
+	got := sdkFilterToModel(filter, &diags)
+	if got.Groups != nil {
+		t.Errorf("groups = %+v, want nil", got.Groups)
+	}
+}
+
+func TestSdkFilterToModel_tooDeepWarns(t *testing.T) {
+	leaf := components.Filter{
+		Conjunction: "and",
+		Clauses:     []components.Clauses{testSDKFilterClause("d", "4")},
+	}
+	level3 := components.Filter{
+		Conjunction: "and",
+		Clauses: []components.Clauses{
+			testSDKFilterClause("c", "3"),
+			components.CreateClausesFilter(leaf),
+		},
+	}
+	level2 := components.Filter{Conjunction: "or", Clauses: []components.Clauses{components.CreateClausesFilter(level3)}}
+	filter := components.Filter{Conjunction: "and", Clauses: []components.Clauses{components.CreateClausesFilter(level2)}}
+
+	var diags diag.Diagnostics
+	got := sdkFilterToModel(filter, &diags)
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected 1 warning, got %v", diags)
+	}
+	sub := got.Groups[0].Groups[0]
+	if len(sub.Clauses) != 1 || sub.Clauses[0].Property.ValueString() != "c" {
+		t.Errorf("subgroup clauses = %+v, want only c", sub.Clauses)
+	}
+}
+
+func TestFilterClauseValueToSDK(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		valueType types.String
+		want      components.Value
+		wantErr   bool
+	}{
+		{"string", "1000", types.StringValue("string"), components.CreateValueStr("1000"), false},
+		{"null type is string", "1000", types.StringNull(), components.CreateValueStr("1000"), false},
+		{"integer", "1000", types.StringValue("integer"), components.CreateValueInteger(1000), false},
+		{"negative integer", "-42", types.StringValue("integer"), components.CreateValueInteger(-42), false},
+		{"integer leading zero", "01000", types.StringValue("integer"), components.Value{}, true},
+		{"integer plus sign", "+1000", types.StringValue("integer"), components.Value{}, true},
+		{"integer float", "10.5", types.StringValue("integer"), components.Value{}, true},
+		{"boolean true", "true", types.StringValue("boolean"), components.CreateValueBoolean(true), false},
+		{"boolean false", "false", types.StringValue("boolean"), components.CreateValueBoolean(false), false},
+		{"boolean capitalized", "True", types.StringValue("boolean"), components.Value{}, true},
+		{"boolean numeric", "1", types.StringValue("boolean"), components.Value{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clause := testFilterClause("tokens", tt.value)
+			clause.ValueType = tt.valueType
+			got, err := filterClauseValueToSDK(clause)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFilterClauses_decimals(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		valueType types.String
+		wantErr   bool
+	}{
+		{"default type decimal", "0.5", types.StringNull(), true},
+		{"default type exponent", "1e3", types.StringNull(), true},
+		{"default type integer", "1000", types.StringNull(), false},
+		{"default type text", "v1.5-beta", types.StringNull(), false},
+		{"explicit string decimal", "0.5", types.StringValue("string"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clause := testFilterClause("ratio", tt.value)
+			clause.ValueType = tt.valueType
+			var diags diag.Diagnostics
+			validateFilterClauses([]FilterClauseModel{clause}, path.Root("filter").AtName("clauses"), &diags)
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("diagnostics = %v, wantErr %v", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFilterModelToSDK_typedValueRoundTrip(t *testing.T) {
+	tokens := testFilterClause("tokens", "1000")
+	tokens.Operator = types.StringValue("gt")
+	tokens.ValueType = types.StringValue("integer")
+	cached := testFilterClause("cached", "false")
+	cached.ValueType = types.StringValue("boolean")
+	model := &FilterModel{
+		Conjunction: types.StringValue("and"),
+		Clauses:     []FilterClauseModel{tokens, cached},
+	}
+
+	var diags diag.Diagnostics
+	sdk := filterModelToSDK(model, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if v := sdk.Clauses[0].FilterClause.Value; v.Integer == nil || *v.Integer != 1000 {
+		t.Errorf("tokens value = %+v, want integer 1000", v)
+	}
+
+	got := sdkFilterToModel(sdk, &diags)
+	if !reflect.DeepEqual(got, model) {
+		t.Errorf("round trip mismatch:\ngot  %+v\nwant %+v", got, model)
+	}
+}
+
+// TestMeterResource_strictConsistency creates a meter against a server whose
+// reads never catch up with the write. Strict mode must fail the create while
+// still saving state, which Terraform records as a tainted resource.
+func TestMeterResource_strictConsistency(t *testing.T) {
+	t.Setenv("POLAR_BASE_URL", "")
+
+	const meterJSON = `{"id": "meter_1", "name": "API Calls", "organization_id": "org_1", "metadata": {},
+		"created_at": %q, "modified_at": null,
+		"filter": {"conjunction": "and", "clauses": [{"property": "name", "operator": "eq", "value": "api_call"}]},
+		"aggregation": {"func": "count"}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, meterJSON, "2025-01-01T00:00:00Z")
+			return
+		}
+		fmt.Fprintf(w, meterJSON, "2024-12-31T23:59:00Z") // stale
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name           string
+		providerStrict bool
+		override       any
+		wantErr        bool
+	}{
+		{name: "lenient", wantErr: false},
+		{name: "provider strict", providerStrict: true, wantErr: true},
+		{name: "resource strict", override: map[string]any{"strict": true}, wantErr: true},
+		{name: "resource lenient overrides provider", providerStrict: true, override: map[string]any{"strict": false}, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			pd, diags := configureTestProvider(t, map[string]any{
+				"access_token": "polar_oat_test",
+				"base_url":     server.URL,
+				"consistency":  map[string]any{"max_attempts": 2, "interval": "1ms", "strict": tt.providerStrict},
+			})
+			if diags.HasError() {
+				t.Fatalf("Configure: %v", diags)
+			}
+
+			r := NewMeterResource()
+			r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: pd}, &fwresource.ConfigureResponse{})
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+			schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: testTerraformValue(t, schemaType, map[string]any{
+				"name": "API Calls",
+				"filter": map[string]any{
+					"conjunction": "and",
+					"clauses":     []any{map[string]any{"property": "name", "operator": "eq", "value": "api_call", "value_type": "string"}},
+				},
+				"aggregation": map[string]any{"func": "count"},
+				"consistency": tt.override,
+			})}
+			resp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)}}
+			r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Fatalf("HasError() = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+			if !tt.wantErr && resp.Diagnostics.WarningsCount() != 1 {
+				t.Errorf("want one consistency warning, got %v", resp.Diagnostics)
+			}
+
+			// State is saved either way, so a failed strict create is tainted
+			// rather than orphaned.
+			var id types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			if id.ValueString() != "meter_1" {
+				t.Errorf("state id = %s, want meter_1", id)
+			}
+		})
+	}
+}
+
+// TestMeterResource_offline runs the meter lifecycle against the polartest
+// fake.
+func TestMeterResource_offline(t *testing.T) {
+	_, factories := testFakeProviderFactories(t)
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProviderConfig + testAccMeterConfig("offline", "and", "name", "eq", "api_call", "count", ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_meter.test",
+						tfjsonpath.New("aggregation").AtMapKey("func"),
+						knownvalue.StringExact("count"),
+					),
+				},
+			},
+			{
+				ResourceName:      "polar_meter.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "polar_meter.test",
+				ImportState:       true,
+				ImportStateId:     "name:offline",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testFakeProviderConfig + testAccMeterConfig("offline-updated", "or", "type", "eq", "usage", "sum", "amount"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_meter.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("offline-updated"),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_meter.test",
+						tfjsonpath.New("aggregation").AtMapKey("property"),
+						knownvalue.StringExact("amount"),
+					),
+				},
+			},
+		},
+	})
+}
+
+// TestMeterResource_offlineLagAndFaults checks that writes survive rate
+// limiting, server errors and reads that trail writes, as they do against
+// the real API.
+func TestMeterResource_offlineLagAndFaults(t *testing.T) {
+	server, factories := testFakeProviderFactories(t)
+	server.SetLag(2)
+	server.InjectFault(polartest.Fault{Method: http.MethodPost, Path: "/v1/meters", Status: http.StatusTooManyRequests, RetryAfter: "0"})
+	server.InjectFault(polartest.Fault{Method: http.MethodGet, Path: "/v1/meters", Status: http.StatusServiceUnavailable, Times: 2})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProviderConfig + testAccMeterConfig("lagging", "and", "name", "eq", "api_call", "count", ""),
+				Check: resource.TestCheckResourceAttrWith("polar_meter.test", "id", func(id string) error {
+					if got := server.Object(id)["name"]; got != "lagging" {
+						return fmt.Errorf("fake has meter name %v, want lagging", got)
+					}
+					return nil
+				}),
+			},
+			{
+				Config: testFakeProviderConfig + testAccMeterConfig("lagging-updated", "and", "name", "eq", "api_call", "count", ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_meter.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("lagging-updated"),
+					),
+				},
+			},
+		},
+	})
+
+	statuses := map[int]int{}
+	for _, r := range server.Requests() {
+		statuses[r.Status]++
+	}
+	if statuses[http.StatusTooManyRequests] != 1 || statuses[http.StatusServiceUnavailable] != 2 {
+		t.Errorf("fake answered %d 429s and %d 503s, want 1 and 2", statuses[http.StatusTooManyRequests], statuses[http.StatusServiceUnavailable])
+	}
+}
+
 func testAccMeterConfig(name, conjunction, property, operator, value, aggFunc, aggProperty string) string {
 	aggAttr := fmt.Sprintf(`
   aggregation = {
@@ -266,3 +726,70 @@ resource "polar_meter" "test" {
 }
 `, name, metadata)
 }
+
+func testAccMeterNestedFilterConfig(name string) string {
+	return fmt.Sprintf(`
+resource "polar_meter" "test" {
+  name = %q
+
+  # (name eq api_call AND plan eq pro) OR name eq admin_call
+  filter = {
+    conjunction = "or"
+    clauses = [{
+      property = "name"
+      operator = "eq"
+      value    = "admin_call"
+    }]
+    groups = [{
+      conjunction = "and"
+      clauses = [
+        { property = "name", operator = "eq", value = "api_call" },
+        { property = "plan", operator = "eq", value = "pro" },
+      ]
+    }]
+  }
+
+  aggregation = {
+    func = "count"
+  }
+}
+`, name)
+}
+
+func testAccMeterTypedFilterConfig(name, tokens string) string {
+	return fmt.Sprintf(`
+resource "polar_meter" "test" {
+  name = %q
+
+  filter = {
+    conjunction = "and"
+    clauses = [
+      { property = "tokens", operator = "gt", value = %q, value_type = "integer" },
+      { property = "name", operator = "eq", value = "completion" },
+    ]
+  }
+
+  aggregation = {
+    func     = "sum"
+    property = "tokens"
+  }
+}
+`, name, tokens)
+}
+
+func testFilterClause(property, value string) FilterClauseModel {
+	return FilterClauseModel{
+		Property:  types.StringValue(property),
+		Operator:  types.StringValue("eq"),
+		Value:     types.StringValue(value),
+		ValueType: types.StringValue("string"),
+	}
+}
+
+func testSDKFilterClause(property, value string) components.Clauses {
+	return components.CreateClausesFilterClause(components.FilterClause{
+		Property: property,
+		Operator: "eq",
+		Value:    components.CreateValueStr(value),
+	})
+}
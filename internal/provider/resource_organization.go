@@ -27,8 +27,9 @@ func NewOrganizationResource() resource.Resource {
 	return &OrganizationResource{}
 }
 
-// OrganizationResource uses adopt-existing lifecycle: Create discovers the org
-// scoped to the access token and updates it (orgs can't be created via API).
+// OrganizationResource uses adopt-existing lifecycle: Create looks up the org
+// (organization_id, or the one the access token is scoped to) and updates it
+// (orgs can't be created via API).
 // Delete just removes from state (orgs can't be deleted). Needs the full
 // PolarProviderData (not just the SDK client) for supplemental HTTP calls.
 type OrganizationResource struct {
@@ -38,18 +39,21 @@ type OrganizationResource struct {
 // --- Terraform model types ---
 
 type OrganizationResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Slug      types.String `tfsdk:"slug"`
-	AvatarURL types.String `tfsdk:"avatar_url"`
-	Email     types.String `tfsdk:"email"`
-	Website   types.String `tfsdk:"website"`
-	Socials   types.List   `tfsdk:"socials"`
+	ID             types.String `tfsdk:"id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Name           types.String `tfsdk:"name"`
+	Slug           types.String `tfsdk:"slug"`
+	AvatarURL      types.String `tfsdk:"avatar_url"`
+	Email          types.String `tfsdk:"email"`
+	Website        types.String `tfsdk:"website"`
+	Socials        types.List   `tfsdk:"socials"`
 
 	FeatureSettings       *FeatureSettingsModel       `tfsdk:"feature_settings"`
 	SubscriptionSettings  *SubscriptionSettingsModel  `tfsdk:"subscription_settings"`
 	NotificationSettings  *NotificationSettingsModel  `tfsdk:"notification_settings"`
 	CustomerEmailSettings *CustomerEmailSettingsModel `tfsdk:"customer_email_settings"`
+
+	Consistency *ConsistencyOverrideModel `tfsdk:"consistency"`
 }
 
 type SocialModel struct {
@@ -98,7 +102,9 @@ func (r *OrganizationResource) Metadata(ctx context.Context, req resource.Metada
 func (r *OrganizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Polar organization's settings. The organization must already exist (created via the Polar UI). " +
-			"The access token is scoped to a single organization — this resource adopts it on create and releases it from state on destroy. " +
+			"This resource adopts the organization on create and releases it from state on destroy. " +
+			"With an organization access token, the token's organization is adopted; with a personal access token, set `organization_id` " +
+			"(here or on the provider) to choose one. Each organization can be managed by at most one `polar_organization` resource. " +
 			"Only include the settings blocks you want Terraform to manage; omitted blocks are left untouched.",
 
 		Attributes: map[string]schema.Attribute{
@@ -109,6 +115,16 @@ func (r *OrganizationResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization to adopt. Defaults to the provider's `organization_id`, or to the access token's organization. " +
+					"Changing this forces a new resource.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the organization.",
 				Optional:            true,
@@ -258,6 +274,7 @@ func (r *OrganizationResource) Schema(ctx context.Context, req resource.SchemaRe
 					},
 				},
 			},
+			"consistency": consistencyOverrideAttribute(),
 		},
 	}
 }
@@ -269,8 +286,9 @@ func (r *OrganizationResource) Configure(ctx context.Context, req resource.Confi
 }
 
 // Create adopts the existing organization rather than creating one.
-// Flow: discover org via token → claim singleton → update settings → poll → save.
+// Flow: find org by ID or via token → claim singleton → update settings → poll → save.
 func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withCorrelationID(ctx)
 	var data OrganizationResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -280,8 +298,9 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 	// Save planned website to restore formatting after API normalizes trailing slash.
 	plannedWebsite := data.Website
 
-	// The access token is scoped to exactly one org — discover it.
-	org, err := discoverOrganization(ctx, r.provider)
+	// Use the configured organization, or discover the one the token is scoped to.
+	organizationID := resolveOrganizationID(data.OrganizationID, r.provider.OrganizationID)
+	org, err := discoverOrganization(ctx, r.provider, organizationID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error discovering organization",
@@ -290,7 +309,7 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	// Singleton guard — only one polar_organization resource per provider.
+	// Singleton guard — only one polar_organization resource per organization.
 	if err := r.provider.ClaimOrganization(org.ID); err != nil {
 		resp.Diagnostics.AddError(
 			"Duplicate organization resource",
@@ -320,7 +339,7 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 	// so we use a raw HTTP PATCH to send complete payloads for these blocks.
 	if data.SubscriptionSettings != nil || data.CustomerEmailSettings != nil {
 		payload := buildSupplementalPayload(&data)
-		if err := patchOrgSupplemental(ctx, r.provider.ServerURL, r.provider.AccessToken, org.ID, payload); err != nil {
+		if err := patchOrgSupplemental(ctx, r.provider, org.ID, payload); err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating supplemental settings",
 				fmt.Sprintf("Could not update supplemental settings: %s", err),
@@ -330,7 +349,7 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Eventual consistency poll.
-	consistent, err := pollForConsistency(ctx, "organization", org.ID, writeTime, func() (*components.Organization, error) {
+	consistent, err := pollForConsistency(ctx, r.provider.Consistency.withOverride(data.Consistency), "organization", org.ID, writeTime, func() (*components.Organization, error) {
 		result, err := r.provider.Client.Organizations.Get(ctx, org.ID)
 		if err != nil {
 			return nil, err
@@ -352,13 +371,14 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 	// Map response to state. Supplemental HTTP GET reads fields the SDK omits.
 	// preserveURLFormatting avoids trailing-slash diffs.
 	mapOrganizationResponseToState(ctx, consistent, &data, &resp.Diagnostics)
-	resp.Diagnostics.Append(mapSupplementalSettings(ctx, r.provider.ServerURL, r.provider.AccessToken, consistent.ID, &data)...)
+	resp.Diagnostics.Append(mapSupplementalSettings(ctx, r.provider, consistent.ID, &data)...)
 	preserveURLFormatting(&data.Website, plannedWebsite)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Read: SDK GET + supplemental HTTP GET for fields the SDK omits.
 func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = withCorrelationID(ctx)
 	var data OrganizationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -377,13 +397,14 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	mapOrganizationResponseToState(ctx, result.Organization, &data, &resp.Diagnostics)
-	resp.Diagnostics.Append(mapSupplementalSettings(ctx, r.provider.ServerURL, r.provider.AccessToken, data.ID.ValueString(), &data)...)
+	resp.Diagnostics.Append(mapSupplementalSettings(ctx, r.provider, data.ID.ValueString(), &data)...)
 	preserveURLFormatting(&data.Website, priorWebsite)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Update: SDK PATCH + supplemental raw HTTP for SDK gap fields → poll → save.
 func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = withCorrelationID(ctx)
 	var data OrganizationResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -412,7 +433,7 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 	// Raw HTTP PATCH for SDK gap fields (same pattern as Create).
 	if data.SubscriptionSettings != nil || data.CustomerEmailSettings != nil {
 		payload := buildSupplementalPayload(&data)
-		if err := patchOrgSupplemental(ctx, r.provider.ServerURL, r.provider.AccessToken, data.ID.ValueString(), payload); err != nil {
+		if err := patchOrgSupplemental(ctx, r.provider, data.ID.ValueString(), payload); err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating supplemental settings",
 				fmt.Sprintf("Could not update supplemental settings: %s", err),
@@ -422,7 +443,7 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Eventual consistency poll.
-	consistent, err := pollForConsistency(ctx, "organization", data.ID.ValueString(), writeTime, func() (*components.Organization, error) {
+	consistent, err := pollForConsistency(ctx, r.provider.Consistency.withOverride(data.Consistency), "organization", data.ID.ValueString(), writeTime, func() (*components.Organization, error) {
 		result, err := r.provider.Client.Organizations.Get(ctx, data.ID.ValueString())
 		if err != nil {
 			return nil, err
@@ -438,14 +459,25 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	mapOrganizationResponseToState(ctx, consistent, &data, &resp.Diagnostics)
-	resp.Diagnostics.Append(mapSupplementalSettings(ctx, r.provider.ServerURL, r.provider.AccessToken, data.ID.ValueString(), &data)...)
+	resp.Diagnostics.Append(mapSupplementalSettings(ctx, r.provider, data.ID.ValueString(), &data)...)
 	preserveURLFormatting(&data.Website, plannedWebsite)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Delete is a no-op — orgs can't be deleted via API. We just drop from TF state.
 func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	tflog.Trace(ctx, "organization released from Terraform management")
+	ctx = withCorrelationID(ctx)
+	var data OrganizationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Let a replacement resource claim the same organization.
+	r.provider.ReleaseOrganization(data.ID.ValueString())
+	tflog.Trace(ctx, "organization released from Terraform management", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
 }
 
 func (r *OrganizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,286 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Compile-time interface conformance checks.
+var (
+	_ resource.Resource                   = &OrganizationAccessTokenResource{}
+	_ resource.ResourceWithValidateConfig = &OrganizationAccessTokenResource{}
+)
+
+func NewOrganizationAccessTokenResource() resource.Resource {
+	return &OrganizationAccessTokenResource{}
+}
+
+// OrganizationAccessTokenResource manages a long-lived organization access
+// token. The token value is only returned on create, so it is kept in state
+// from then on and import is not supported. Needs the full PolarProviderData
+// for the raw HTTP calls (the SDK has no access token endpoints).
+type OrganizationAccessTokenResource struct {
+	provider *PolarProviderData
+}
+
+type OrganizationAccessTokenResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Comment        types.String `tfsdk:"comment"`
+	Scopes         types.Set    `tfsdk:"scopes"`
+	ExpiresIn      types.String `tfsdk:"expires_in"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	Token          types.String `tfsdk:"token"`
+}
+
+func (r *OrganizationAccessTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_access_token"
+}
+
+func (r *OrganizationAccessTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Polar organization access token with least-privilege scopes, e.g. one per service.\n\n" +
+			"The token value is only available when the token is created and is stored in state as a sensitive value. " +
+			"To rotate a token, replace it (change `expires_in`, or use `replace_triggered_by` with a rotation schedule) " +
+			"and set `lifecycle { create_before_destroy = true }` so the new token exists before the old one is revoked. " +
+			"Import is not supported because the token value can't be read back.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The access token ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": organizationIDAttribute("access token"),
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "A comment shown next to the token in the Polar dashboard, e.g. the service that uses it.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "The scopes granted to the token, e.g. `products:read`. Can be changed without replacing the token.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(organizationAccessTokenScopes...)),
+				},
+			},
+			"expires_in": schema.StringAttribute{
+				MarkdownDescription: "How long the token is valid for after creation, as a Go duration string (e.g. `720h`). Omit for a token that never expires. " +
+					"Changing this forces a new token.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the token expires (RFC 3339). Null if the token never expires.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The access token value. Only returned by the API on create.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationAccessTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
+		r.provider = pd
+	}
+}
+
+func (r *OrganizationAccessTokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OrganizationAccessTokenResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ExpiresIn.IsNull() || data.ExpiresIn.IsUnknown() {
+		return
+	}
+	if _, err := parseTokenExpiresIn(data.ExpiresIn.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_in"),
+			"Invalid expires_in",
+			err.Error(),
+		)
+	}
+}
+
+// Create mints the token. The create response is used directly: the token
+// value isn't returned by any later read, so there is nothing to poll for.
+func (r *OrganizationAccessTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withCorrelationID(ctx)
+	var data OrganizationAccessTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var scopes []string
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.OrganizationID = resolveOrganizationID(data.OrganizationID, r.provider.OrganizationID)
+	payload := &orgAccessTokenCreatePayload{
+		Comment:        data.Comment.ValueString(),
+		Scopes:         scopes,
+		OrganizationID: optionalStringPointer(data.OrganizationID),
+	}
+	if !data.ExpiresIn.IsNull() {
+		d, err := parseTokenExpiresIn(data.ExpiresIn.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_in"), "Invalid expires_in", err.Error())
+			return
+		}
+		iso := isoDuration(d)
+		payload.ExpiresIn = &iso
+	}
+
+	result, err := createOrgAccessToken(ctx, r.provider, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating organization access token",
+			fmt.Sprintf("Could not create organization access token: %s", err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "created organization access token", map[string]interface{}{
+		"id": result.OrganizationAccessToken.ID,
+	})
+
+	data.Token = types.StringValue(result.Token)
+	mapOrgAccessTokenToState(ctx, &result.OrganizationAccessToken, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes comment, scopes and expiry. Tokens revoked out-of-band are
+// removed from state so Terraform mints a new one.
+func (r *OrganizationAccessTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = withCorrelationID(ctx)
+	var data OrganizationAccessTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := getOrgAccessToken(ctx, r.provider, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading organization access token",
+			fmt.Sprintf("Could not read organization access token %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+	if token == nil {
+		tflog.Trace(ctx, "organization access token not found, removing from state", map[string]interface{}{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapOrgAccessTokenToState(ctx, token, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update changes comment and scopes in place; the token value is unchanged.
+func (r *OrganizationAccessTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = withCorrelationID(ctx)
+	var data OrganizationAccessTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var scopes []string
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	comment := data.Comment.ValueString()
+
+	token, err := updateOrgAccessToken(ctx, r.provider, data.ID.ValueString(), &orgAccessTokenUpdatePayload{
+		Comment: &comment,
+		Scopes:  scopes,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating organization access token",
+			fmt.Sprintf("Could not update organization access token %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	mapOrgAccessTokenToState(ctx, token, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete revokes the token. Already-revoked tokens are a no-op.
+func (r *OrganizationAccessTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withCorrelationID(ctx)
+	var data OrganizationAccessTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := deleteOrgAccessToken(ctx, r.provider, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting organization access token",
+			fmt.Sprintf("Could not delete organization access token %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted organization access token", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// mapOrgAccessTokenToState maps an access token API response to the model.
+// token and expires_in aren't returned by the API and are left as-is.
+func mapOrgAccessTokenToState(ctx context.Context, token *orgAccessToken, data *OrganizationAccessTokenResourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(token.ID)
+	data.OrganizationID = types.StringValue(token.OrganizationID)
+	data.Comment = types.StringValue(token.Comment)
+
+	scopes, d := types.SetValueFrom(ctx, types.StringType, token.Scopes)
+	diags.Append(d...)
+	data.Scopes = scopes
+
+	data.ExpiresAt = types.StringNull()
+	if token.ExpiresAt != nil {
+		data.ExpiresAt = types.StringValue(token.ExpiresAt.Format(time.RFC3339))
+	}
+}
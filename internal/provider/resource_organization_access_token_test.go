@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOrganizationAccessTokenResource_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	sameToken := statecheck.CompareValue(compare.ValuesSame())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create
+			{
+				Config: testAccOrganizationAccessTokenConfig(rName, `["products:read"]`, "720h"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_organization_access_token.test",
+						tfjsonpath.New("token"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^polar_oat_`)),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_organization_access_token.test",
+						tfjsonpath.New("expires_at"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_organization_access_token.test",
+						tfjsonpath.New("organization_id"),
+						knownvalue.NotNull(),
+					),
+					sameToken.AddStateValue("polar_organization_access_token.test", tfjsonpath.New("token")),
+				},
+			},
+			// Changing scopes updates in place and keeps the token value
+			{
+				Config: testAccOrganizationAccessTokenConfig(rName, `["products:read", "benefits:read"]`, "720h"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("polar_organization_access_token.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_organization_access_token.test",
+						tfjsonpath.New("scopes"),
+						knownvalue.SetSizeExact(2),
+					),
+					sameToken.AddStateValue("polar_organization_access_token.test", tfjsonpath.New("token")),
+				},
+			},
+			// Changing expires_in rotates the token, creating the new one first
+			{
+				Config: testAccOrganizationAccessTokenConfig(rName, `["products:read", "benefits:read"]`, "1440h"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("polar_organization_access_token.test", plancheck.ResourceActionCreateBeforeDestroy),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestMapOrgAccessTokenToState(t *testing.T) {
+	ctx := context.Background()
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		token         orgAccessToken
+		wantExpiresAt types.String
+	}{
+		{
+			name:          "expiring",
+			token:         orgAccessToken{ID: "oat_1", OrganizationID: "org_1", Comment: "ci", Scopes: []string{"products:read"}, ExpiresAt: &expiresAt},
+			wantExpiresAt: types.StringValue("2030-01-02T03:04:05Z"),
+		},
+		{
+			name:          "never expires",
+			token:         orgAccessToken{ID: "oat_2", OrganizationID: "org_1", Comment: "ci", Scopes: []string{"products:read"}},
+			wantExpiresAt: types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			data := OrganizationAccessTokenResourceModel{
+				Token:     types.StringValue("polar_oat_secret"),
+				ExpiresIn: types.StringValue("720h"),
+			}
+			mapOrgAccessTokenToState(ctx, &tt.token, &data, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if data.ID.ValueString() != tt.token.ID || data.Comment.ValueString() != "ci" {
+				t.Errorf("id/comment = %s/%s, want %s/ci", data.ID, data.Comment, tt.token.ID)
+			}
+			if got := data.OrganizationID.ValueString(); got != "org_1" {
+				t.Errorf("organization_id = %q, want %q", got, "org_1")
+			}
+			if !data.ExpiresAt.Equal(tt.wantExpiresAt) {
+				t.Errorf("expires_at = %s, want %s", data.ExpiresAt, tt.wantExpiresAt)
+			}
+			if len(data.Scopes.Elements()) != 1 {
+				t.Errorf("scopes = %s, want 1 element", data.Scopes)
+			}
+			// Values the API doesn't return must survive the mapping.
+			if data.Token.ValueString() != "polar_oat_secret" || data.ExpiresIn.ValueString() != "720h" {
+				t.Errorf("token/expires_in were overwritten: %s/%s", data.Token, data.ExpiresIn)
+			}
+		})
+	}
+}
+
+// --- Config helpers ---
+
+func testAccOrganizationAccessTokenConfig(comment, scopes, expiresIn string) string {
+	return fmt.Sprintf(`
+resource "polar_organization_access_token" "test" {
+  comment    = %q
+  scopes     = %s
+  expires_in = %q
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, comment, scopes, expiresIn)
+}
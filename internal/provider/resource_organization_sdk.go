@@ -3,34 +3,34 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
-	"math"
 	"net/http"
 	"net/url"
-	"strconv"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/polarsource/polar-go/models/components"
 )
 
-// supplementalHTTPClient is a dedicated client for raw HTTP calls that bypass the SDK.
-// Uses an explicit timeout to prevent indefinite hangs.
-var supplementalHTTPClient = &http.Client{
-	Timeout: 30 * time.Second,
-}
+// --- Discover the organization to adopt ---
+// An explicit organization ID is fetched directly. Otherwise the access token
+// must be scoped to exactly one organization (true for organization access
+// tokens); personal tokens that reach several need organization_id.
 
-// --- Discover the single organization scoped to the access token ---
-// Polar access tokens are org-scoped, so listing orgs should return exactly one.
+func discoverOrganization(ctx context.Context, client *PolarProviderData, organizationID string) (*components.Organization, error) {
+	if organizationID != "" {
+		result, err := client.Client.Organizations.Get(ctx, organizationID)
+		if err != nil {
+			if isNotFound(err) {
+				return nil, fmt.Errorf("organization %s not found or not accessible with the configured access token", organizationID)
+			}
+			return nil, fmt.Errorf("getting organization %s: %w", organizationID, err)
+		}
+		return result.Organization, nil
+	}
 
-func discoverOrganization(ctx context.Context, client *PolarProviderData) (*components.Organization, error) {
 	resp, err := client.Client.Organizations.List(ctx, nil, nil, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("listing organizations: %w", err)
@@ -39,7 +39,8 @@ func discoverOrganization(ctx context.Context, client *PolarProviderData) (*comp
 		return nil, fmt.Errorf("no organizations found for the configured access token")
 	}
 	if len(resp.ListResourceOrganization.Items) > 1 {
-		return nil, fmt.Errorf("expected exactly 1 organization, found %d", len(resp.ListResourceOrganization.Items))
+		return nil, fmt.Errorf("the access token can access %d organizations; set organization_id on the resource or provider to choose one",
+			len(resp.ListResourceOrganization.Items))
 	}
 	org := resp.ListResourceOrganization.Items[0]
 	return &org, nil
@@ -124,6 +125,7 @@ func buildOrganizationUpdate(ctx context.Context, data *OrganizationResourceMode
 
 func mapOrganizationResponseToState(ctx context.Context, org *components.Organization, data *OrganizationResourceModel, diags *diag.Diagnostics) {
 	data.ID = types.StringValue(org.ID)
+	data.OrganizationID = types.StringValue(org.ID)
 	data.Slug = types.StringValue(org.Slug)
 
 	// Profile fields: only set if user included them in config.
@@ -246,13 +248,13 @@ func buildSupplementalPayload(data *OrganizationResourceModel) *orgSupplementalU
 
 // mapSupplementalSettings reads fields the SDK omits from the API via raw HTTP
 // GET and updates state. Handles both subscription_settings and customer_email_settings.
-func mapSupplementalSettings(ctx context.Context, serverURL, token, orgID string, data *OrganizationResourceModel) diag.Diagnostics {
+func mapSupplementalSettings(ctx context.Context, pd *PolarProviderData, orgID string, data *OrganizationResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 	if data.SubscriptionSettings == nil && data.CustomerEmailSettings == nil {
 		return diags
 	}
 
-	supplemental, err := getOrgSupplemental(ctx, serverURL, token, orgID)
+	supplemental, err := getOrgSupplemental(ctx, pd, orgID)
 	if err != nil {
 		diags.AddWarning(
 			"Could not read supplemental settings",
@@ -288,139 +290,14 @@ func mapSupplementalSettings(ctx context.Context, serverURL, token, orgID string
 	return diags
 }
 
-// patchOrgSupplemental sends a raw HTTP PATCH with retry for fields the SDK doesn't support.
-func patchOrgSupplemental(ctx context.Context, serverURL, token, orgID string, payload *orgSupplementalUpdatePayload) error {
-	body, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("marshaling supplemental settings: %w", err)
-	}
-
-	return doWithRetry(ctx, func() (*http.Response, error) {
-		url := fmt.Sprintf("%s/v1/organizations/%s", serverURL, url.PathEscape(orgID))
-		req, err := http.NewRequestWithContext(ctx, http.MethodPatch, url, bytes.NewReader(body))
-		if err != nil {
-			return nil, fmt.Errorf("creating request: %w", err)
-		}
-		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("Authorization", "Bearer "+token)
-		return supplementalHTTPClient.Do(req)
-	})
+// patchOrgSupplemental sends the fields the SDK doesn't support as a raw PATCH.
+func patchOrgSupplemental(ctx context.Context, pd *PolarProviderData, orgID string, payload *orgSupplementalUpdatePayload) error {
+	return pd.Supplemental.do(ctx, http.MethodPatch, "/v1/organizations/"+url.PathEscape(orgID), payload, nil)
 }
 
-// getOrgSupplemental reads settings the SDK omits via raw HTTP GET with retry.
-func getOrgSupplemental(ctx context.Context, serverURL, token, orgID string) (*orgSupplementalGetResponse, error) {
-	var result orgSupplementalGetResponse
-
-	err := doWithRetry(ctx, func() (*http.Response, error) {
-		reqURL := fmt.Sprintf("%s/v1/organizations/%s", serverURL, url.PathEscape(orgID))
-		req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
-		if err != nil {
-			return nil, fmt.Errorf("creating request: %w", err)
-		}
-		req.Header.Set("Authorization", "Bearer "+token)
-		resp, err := supplementalHTTPClient.Do(req)
-		if err != nil {
-			return nil, err
-		}
-		// On success, decode and let doWithRetry handle body close
-		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-			body, readErr := io.ReadAll(resp.Body)
-			resp.Body.Close()
-			if readErr != nil {
-				return nil, fmt.Errorf("reading response body: %w", readErr)
-			}
-			if decodeErr := json.Unmarshal(body, &result); decodeErr != nil {
-				return nil, fmt.Errorf("decoding response: %w", decodeErr)
-			}
-			// Return nil response — body already consumed and closed
-			return nil, nil
-		}
-		return resp, nil
-	})
-	if err != nil {
-		return nil, err
-	}
-	return &result, nil
-}
-
-// doWithRetry executes fn with exponential backoff on 429 (rate limit) and 5xx
-// (server errors). If fn returns (nil, nil), it means the caller already consumed
-// and closed the response body on success (see getOrgSupplemental).
-// Respects Retry-After headers when present on 429 responses.
-func doWithRetry(ctx context.Context, fn func() (*http.Response, error)) error {
-	const initialBackoff = 500 * time.Millisecond
-	const maxBackoff = 30 * time.Second
-	const maxElapsed = 120 * time.Second
-
-	start := time.Now()
-	for attempt := 0; ; attempt++ {
-		resp, err := fn()
-		if err != nil {
-			return err
-		}
-
-		// nil response means caller already handled a successful response
-		if resp == nil {
-			return nil
-		}
-
-		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-			resp.Body.Close()
-			return nil
-		}
-
-		respBody, readErr := io.ReadAll(resp.Body)
-		resp.Body.Close()
-		if readErr != nil {
-			respBody = []byte("(failed to read response body)")
-		}
-
-		// Retry on 429 or 5xx if we haven't exceeded the max elapsed time.
-		if resp.StatusCode == 429 || resp.StatusCode >= 500 {
-			backoff := retryBackoff(resp, attempt, initialBackoff, maxBackoff)
-			if time.Since(start)+backoff <= maxElapsed {
-				tflog.Debug(ctx, "retrying supplemental HTTP request", map[string]interface{}{
-					"status":  resp.StatusCode,
-					"attempt": attempt + 1,
-					"backoff": backoff.String(),
-				})
-				select {
-				case <-ctx.Done():
-					return ctx.Err()
-				case <-time.After(backoff):
-					continue
-				}
-			}
-		}
-
-		// Log full response body at debug level; return only status in user-facing error
-		// to avoid leaking internal API details.
-		tflog.Debug(ctx, "supplemental HTTP error response", map[string]interface{}{
-			"status": resp.StatusCode,
-			"body":   string(respBody),
-		})
-		return fmt.Errorf("supplemental HTTP request failed with status %d", resp.StatusCode)
-	}
-}
-
-// retryBackoff computes the backoff duration for a retry. If the response
-// includes a Retry-After header (seconds), that value is used (capped at
-// maxBackoff). Otherwise, exponential backoff is applied.
-func retryBackoff(resp *http.Response, attempt int, initialBackoff, maxBackoff time.Duration) time.Duration {
-	if ra := resp.Header.Get("Retry-After"); ra != "" {
-		if seconds, err := strconv.Atoi(ra); err == nil && seconds > 0 {
-			d := time.Duration(seconds) * time.Second
-			if d > maxBackoff {
-				d = maxBackoff
-			}
-			return d
-		}
-	}
-	backoff := time.Duration(float64(initialBackoff) * math.Pow(1.5, float64(attempt)))
-	if backoff > maxBackoff {
-		backoff = maxBackoff
-	}
-	return backoff
+// getOrgSupplemental reads settings the SDK omits via a raw GET.
+func getOrgSupplemental(ctx context.Context, pd *PolarProviderData, orgID string) (*orgSupplementalGetResponse, error) {
+	return supplementalGet[orgSupplementalGetResponse](ctx, pd.Supplemental, "/v1/organizations/"+url.PathEscape(orgID))
 }
 
 // --- Helpers ---
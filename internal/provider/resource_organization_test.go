@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/sjkchang/terraform-provider-polar/internal/polartest"
 )
 
 func TestAccOrganizationResource_basic(t *testing.T) {
@@ -188,6 +189,62 @@ func TestAccOrganizationResource_emailAndNotificationSettings(t *testing.T) {
 	})
 }
 
+// TestOrganizationResource_offline adopts the polartest fake's organization
+// and updates settings the SDK models as well as those sent as a raw PATCH.
+func TestOrganizationResource_offline(t *testing.T) {
+	_, factories := testFakeProviderFactories(t)
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProviderConfig + testAccOrganizationMinimal(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_organization.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact(polartest.OrganizationID),
+					),
+				},
+			},
+			{
+				ResourceName:      "polar_organization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testFakeProviderConfig + testAccOrganizationProfile("Offline Org", "https://offline.example.com"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_organization.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("Offline Org"),
+					),
+				},
+			},
+			{
+				Config: testFakeProviderConfig + testAccOrganizationSubscriptionSettings("invoice", 7),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_organization.test",
+						tfjsonpath.New("subscription_settings").AtMapKey("benefit_revocation_grace_period"),
+						knownvalue.Int64Exact(7),
+					),
+				},
+			},
+			{
+				Config: testFakeProviderConfig + testAccOrganizationEmailAndNotificationSettings(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_organization.test",
+						tfjsonpath.New("customer_email_settings").AtMapKey("subscription_cycled_after_trial"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+		},
+	})
+}
+
 // --- Config helpers ---
 
 func testAccOrganizationMinimal() string {
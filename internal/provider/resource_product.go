@@ -5,8 +5,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -30,7 +33,11 @@ func NewProductResource() resource.Resource {
 }
 
 type ProductResource struct {
-	client *polargo.Polar
+	client         *polargo.Polar
+	supplemental   *supplementalClient // detaches custom fields ProductUpdate can't
+	organizationID string              // provider default, see resolveOrganizationID
+	consistency    consistencyPolicy   // provider consistency settings, see pollForConsistency
+	retry          retryPolicy         // provider retry settings, see retryPolicy.createOption
 }
 
 // --- Terraform model types ---
@@ -40,6 +47,7 @@ type ProductResource struct {
 // be either one-time or recurring (determined by recurring_interval).
 type ProductResourceModel struct {
 	ID                types.String `tfsdk:"id"`
+	OrganizationID    types.String `tfsdk:"organization_id"`
 	Name              types.String `tfsdk:"name"`
 	Description       types.String `tfsdk:"description"`
 	RecurringInterval types.String `tfsdk:"recurring_interval"`
@@ -48,6 +56,22 @@ type ProductResourceModel struct {
 	Metadata          types.Map    `tfsdk:"metadata"`
 	Medias            types.List   `tfsdk:"medias"`
 	IsArchived        types.Bool   `tfsdk:"is_archived"`
+
+	AttachedCustomFields []AttachedCustomFieldModel `tfsdk:"attached_custom_fields"`
+}
+
+// productResourceData is the polar_product resource's state: ProductResourceModel, which
+// the product data sources share, plus resource-only settings.
+type productResourceData struct {
+	ProductResourceModel
+	Consistency *ConsistencyOverrideModel `tfsdk:"consistency"`
+}
+
+// AttachedCustomFieldModel attaches a polar_custom_field to the product's
+// checkout form. List order determines the display order.
+type AttachedCustomFieldModel struct {
+	CustomFieldID types.String `tfsdk:"custom_field_id"`
+	Required      types.Bool   `tfsdk:"required"`
 }
 
 // PriceModel is a flat struct that covers all price types. The `amount_type`
@@ -56,6 +80,7 @@ type ProductResourceModel struct {
 // - "free":         (no extra fields)
 // - "custom":       minimum_amount, maximum_amount, preset_amount, price_currency
 // - "metered_unit": meter_id, unit_amount, cap_amount, price_currency
+// - "seat_based":   seat_tiers, price_currency
 // Unused fields are set to null in state.
 type PriceModel struct {
 	AmountType    types.String `tfsdk:"amount_type"`
@@ -70,6 +95,16 @@ type PriceModel struct {
 	MeterID    types.String `tfsdk:"meter_id"`
 	UnitAmount types.String `tfsdk:"unit_amount"`
 	CapAmount  types.Int64  `tfsdk:"cap_amount"`
+	// Seat-based
+	SeatTiers []SeatTierModel `tfsdk:"seat_tiers"`
+}
+
+// SeatTierModel is one tier of a seat_based price. Tiers must be contiguous;
+// only the last tier may omit max_seats (unlimited).
+type SeatTierModel struct {
+	MinSeats     types.Int64 `tfsdk:"min_seats"`
+	MaxSeats     types.Int64 `tfsdk:"max_seats"`
+	PricePerSeat types.Int64 `tfsdk:"price_per_seat"`
 }
 
 // --- Resource interface ---
@@ -90,6 +125,7 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"organization_id": organizationIDAttribute("product"),
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the product.",
 				Required:            true,
@@ -116,14 +152,14 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"amount_type": schema.StringAttribute{
-							MarkdownDescription: "The price type. Must be one of: `fixed`, `free`, `custom`, `metered_unit`.",
+							MarkdownDescription: "The price type. Must be one of: `fixed`, `free`, `custom`, `metered_unit`, `seat_based`.",
 							Required:            true,
 							Validators: []validator.String{
-								stringvalidator.OneOf("fixed", "free", "custom", "metered_unit"),
+								stringvalidator.OneOf("fixed", "free", "custom", "metered_unit", "seat_based"),
 							},
 						},
 						"price_currency": schema.StringAttribute{
-							MarkdownDescription: "The currency code (e.g. `usd`). Defaults to `usd`. Applies to `fixed`, `custom`, and `metered_unit` types.",
+							MarkdownDescription: "The currency code (e.g. `usd`). Defaults to `usd`. Applies to `fixed`, `custom`, `metered_unit`, and `seat_based` types.",
 							Optional:            true,
 							Computed:            true,
 							PlanModifiers: []planmodifier.String{
@@ -161,6 +197,33 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 							MarkdownDescription: "Maximum amount in cents that can be charged regardless of units consumed. For `metered_unit` type.",
 							Optional:            true,
 						},
+						// Seat-based
+						"seat_tiers": schema.ListNestedAttribute{
+							MarkdownDescription: "Per-seat pricing tiers, ordered by seat count. Required when `amount_type` is `seat_based`. Tiers must be contiguous, and only the last tier may omit `max_seats`.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"min_seats": schema.Int64Attribute{
+										MarkdownDescription: "Minimum number of seats for this tier (inclusive).",
+										Required:            true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+									"max_seats": schema.Int64Attribute{
+										MarkdownDescription: "Maximum number of seats for this tier (inclusive). Omit for unlimited.",
+										Optional:            true,
+									},
+									"price_per_seat": schema.Int64Attribute{
+										MarkdownDescription: "Price per seat in cents for this tier.",
+										Required:            true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -181,18 +244,37 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"attached_custom_fields": schema.ListNestedAttribute{
+				MarkdownDescription: "Custom fields to collect at checkout, in display order. Checkout links for this product show the same fields. Uses replace-all semantics — the full list is sent on every apply. Omit to leave custom fields unmanaged by Terraform.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"custom_field_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the custom field to attach.",
+							Required:            true,
+						},
+						"required": schema.BoolAttribute{
+							MarkdownDescription: "Whether customers must fill in the field to complete checkout. Defaults to `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
 			"is_archived": schema.BoolAttribute{
 				MarkdownDescription: "Whether the product is archived. Defaults to `false`. Set to `true` to archive a product while keeping it in Terraform state.",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"consistency": consistencyOverrideAttribute(),
 		},
 	}
 }
 
 func (r *ProductResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data ProductResourceModel
+	var data productResourceData
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -254,6 +336,14 @@ func (r *ProductResource) ValidateConfig(ctx context.Context, req resource.Valid
 					"unit_amount is required when amount_type is \"metered_unit\".",
 				)
 			}
+		case "seat_based":
+			if len(price.SeatTiers) == 0 {
+				resp.Diagnostics.AddAttributeError(
+					pricePath.AtName("seat_tiers"),
+					"Missing required field",
+					"seat_tiers is required when amount_type is \"seat_based\".",
+				)
+			}
 		}
 
 		// --- Conflicting fields: reject fields that don't belong to this type ---
@@ -306,6 +396,19 @@ func (r *ProductResource) ValidateConfig(ctx context.Context, req resource.Valid
 			}
 		}
 
+		if amountType != "seat_based" && price.SeatTiers != nil {
+			resp.Diagnostics.AddAttributeError(
+				pricePath.AtName("seat_tiers"),
+				"Unexpected field",
+				fmt.Sprintf("seat_tiers is not used when amount_type is %q.", amountType),
+			)
+		}
+
+		// --- Logical constraints for seat tiers ---
+		if amountType == "seat_based" {
+			validateSeatTiers(price.SeatTiers, pricePath.AtName("seat_tiers"), &resp.Diagnostics)
+		}
+
 		// --- Logical constraints for custom prices ---
 		if amountType == "custom" && !price.MinimumAmount.IsNull() && !price.MaximumAmount.IsNull() && !price.PresetAmount.IsNull() {
 			minAmt := price.MinimumAmount.ValueInt64()
@@ -332,6 +435,10 @@ func (r *ProductResource) ValidateConfig(ctx context.Context, req resource.Valid
 func (r *ProductResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
 		r.client = pd.Client
+		r.supplemental = pd.Supplemental
+		r.consistency = pd.Consistency
+		r.retry = pd.Retry
+		r.organizationID = pd.OrganizationID
 	}
 }
 
@@ -339,20 +446,22 @@ func (r *ProductResource) Configure(ctx context.Context, req resource.ConfigureR
 // Products have a two-step creation: create the product, then attach benefits
 // via a separate API call (benefits are managed independently of the product).
 func (r *ProductResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data ProductResourceModel
+	ctx = withCorrelationID(ctx)
+	var data productResourceData
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Build the SDK request — dispatches to recurring or one-time based on recurring_interval.
-	createReq, diags := buildProductCreateRequest(ctx, &data)
+	data.OrganizationID = resolveOrganizationID(data.OrganizationID, r.organizationID)
+	createReq, diags := buildProductCreateRequest(ctx, &data.ProductResourceModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	result, err := r.client.Products.Create(ctx, *createReq)
+	result, err := r.client.Products.Create(ctx, *createReq, r.retry.createOption())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating product",
@@ -393,7 +502,7 @@ func (r *ProductResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Eventual consistency poll.
 	writeTime := latestTimestamp(result.Product)
-	product, err := pollForConsistency(ctx, "product", result.Product.ID, writeTime, func() (*components.Product, error) {
+	product, err := pollForConsistency(ctx, r.consistency.withOverride(data.Consistency), "product", result.Product.ID, writeTime, func() (*components.Product, error) {
 		r, err := r.client.Products.Get(ctx, result.Product.ID)
 		if err != nil {
 			return nil, err
@@ -411,7 +520,7 @@ func (r *ProductResource) Create(ctx context.Context, req resource.CreateRequest
 	// Map response → state. Preserve the user's unit_amount formatting so
 	// "0.50" doesn't drift to "0.5" and cause spurious diffs.
 	plannedPrices := data.Prices
-	mapProductResponseToState(ctx, product, &data, &resp.Diagnostics)
+	mapProductResponseToState(ctx, product, &data.ProductResourceModel, &resp.Diagnostics)
 	preserveUnitAmountFormatting(data.Prices, plannedPrices)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -419,7 +528,8 @@ func (r *ProductResource) Create(ctx context.Context, req resource.CreateRequest
 // Read refreshes TF state from the API. Archived products are treated as deleted.
 // Preserves the user's unit_amount formatting from prior state.
 func (r *ProductResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data ProductResourceModel
+	ctx = withCorrelationID(ctx)
+	var data productResourceData
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -448,7 +558,7 @@ func (r *ProductResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	mapProductResponseToState(ctx, result.Product, &data, &resp.Diagnostics)
+	mapProductResponseToState(ctx, result.Product, &data.ProductResourceModel, &resp.Diagnostics)
 	preserveUnitAmountFormatting(data.Prices, priorPrices)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -457,7 +567,8 @@ func (r *ProductResource) Read(ctx context.Context, req resource.ReadRequest, re
 // We fetch current prices first so we can match unchanged prices by value and
 // reuse their IDs, avoiding unnecessary price recreation on the Polar side.
 func (r *ProductResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ProductResourceModel
+	ctx = withCorrelationID(ctx)
+	var data productResourceData
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -473,12 +584,21 @@ func (r *ProductResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	updateReq, diags := buildProductUpdateRequest(ctx, &data, current.Product.Prices)
+	updateReq, diags := buildProductUpdateRequest(ctx, &data.ProductResourceModel, current.Product.Prices)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	clearPayload := buildProductClearPayload(&data.ProductResourceModel)
+	if err := patchClearedFields(ctx, r.supplemental, "/v1/products/"+url.PathEscape(data.ID.ValueString()), clearPayload); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating product",
+			fmt.Sprintf("Could not detach custom fields from product %s: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
 	plannedPrices := data.Prices
 	updateResult, err := r.client.Products.Update(ctx, data.ID.ValueString(), *updateReq)
 	if err != nil {
@@ -510,7 +630,7 @@ func (r *ProductResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	productID := data.ID.ValueString()
-	product, err := pollForConsistency(ctx, "product", productID, writeTime, func() (*components.Product, error) {
+	product, err := pollForConsistency(ctx, r.consistency.withOverride(data.Consistency), "product", productID, writeTime, func() (*components.Product, error) {
 		r, err := r.client.Products.Get(ctx, productID)
 		if err != nil {
 			return nil, err
@@ -525,7 +645,7 @@ func (r *ProductResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	mapProductResponseToState(ctx, product, &data, &resp.Diagnostics)
+	mapProductResponseToState(ctx, product, &data.ProductResourceModel, &resp.Diagnostics)
 	preserveUnitAmountFormatting(data.Prices, plannedPrices)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -533,7 +653,8 @@ func (r *ProductResource) Update(ctx context.Context, req resource.UpdateRequest
 // Delete archives the product (Polar has no DELETE for products).
 // Archived products are treated as "gone" by Read.
 func (r *ProductResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data ProductResourceModel
+	ctx = withCorrelationID(ctx)
+	var data productResourceData
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -559,6 +680,58 @@ func (r *ProductResource) Delete(ctx context.Context, req resource.DeleteRequest
 	})
 }
 
+// ImportState accepts an ID, "name:<name>" or "metadata:<key>=<value>".
 func (r *ProductResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ctx = withCorrelationID(ctx)
+	importStateByLookup(ctx, req, resp, func(lookup objectLookup, diags *diag.Diagnostics) string {
+		organizationID := resolveOrganizationID(types.StringNull(), r.organizationID)
+		if p := findProduct(ctx, r.client, lookup, organizationID, diags); p != nil {
+			return p.ID
+		}
+		return ""
+	})
+}
+
+// validateSeatTiers checks that seat tiers form a contiguous range starting at
+// the first tier's min_seats: each tier's max_seats is at least its min_seats,
+// each following tier starts right after the previous one ends, and only the
+// last tier may be unbounded.
+func validateSeatTiers(tiers []SeatTierModel, tiersPath path.Path, diags *diag.Diagnostics) {
+	for i, tier := range tiers {
+		if tier.MinSeats.IsUnknown() || tier.MaxSeats.IsUnknown() {
+			return
+		}
+		tierPath := tiersPath.AtListIndex(i)
+		minSeats := tier.MinSeats.ValueInt64()
+
+		if tier.MaxSeats.IsNull() {
+			if i != len(tiers)-1 {
+				diags.AddAttributeError(
+					tierPath.AtName("max_seats"),
+					"Invalid seat tier",
+					"Only the last seat tier may omit max_seats.",
+				)
+			}
+			continue
+		}
+
+		maxSeats := tier.MaxSeats.ValueInt64()
+		if maxSeats < minSeats {
+			diags.AddAttributeError(
+				tierPath.AtName("max_seats"),
+				"Invalid seat tier",
+				fmt.Sprintf("max_seats (%d) must be greater than or equal to min_seats (%d).", maxSeats, minSeats),
+			)
+		}
+		if i+1 < len(tiers) {
+			next := tiers[i+1].MinSeats
+			if !next.IsUnknown() && next.ValueInt64() != maxSeats+1 {
+				diags.AddAttributeError(
+					tiersPath.AtListIndex(i+1).AtName("min_seats"),
+					"Invalid seat tier",
+					fmt.Sprintf("min_seats (%d) must be %d to continue from the previous tier's max_seats (%d).", next.ValueInt64(), maxSeats+1, maxSeats),
+				)
+			}
+		}
+	}
 }
@@ -4,6 +4,7 @@ package provider
 
 import (
 	"context"
+	"sort"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -14,7 +15,7 @@ import (
 // --- Build SDK Create request ---
 // Products are polymorphic at two levels:
 // 1. Recurring vs one-time (determined by recurring_interval being set)
-// 2. Price type (fixed/free/custom/metered_unit per price entry)
+// 2. Price type (fixed/free/custom/metered_unit/seat_based per price entry)
 
 func buildProductCreateRequest(ctx context.Context, data *ProductResourceModel) (*components.ProductCreate, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -45,11 +46,13 @@ func buildProductCreateRequest(ctx context.Context, data *ProductResourceModel)
 		}
 
 		recurring := components.ProductCreateRecurring{
-			Name:              name,
-			Description:       description,
-			Prices:            prices,
-			RecurringInterval: components.SubscriptionRecurringInterval(data.RecurringInterval.ValueString()),
-			Medias:            medias,
+			Name:                 name,
+			Description:          description,
+			Prices:               prices,
+			RecurringInterval:    components.SubscriptionRecurringInterval(data.RecurringInterval.ValueString()),
+			Medias:               medias,
+			AttachedCustomFields: attachedCustomFieldsToSDK(data.AttachedCustomFields),
+			OrganizationID:       optionalStringPointer(data.OrganizationID),
 		}
 
 		if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
@@ -77,6 +80,9 @@ func buildProductCreateRequest(ctx context.Context, data *ProductResourceModel)
 		Description: description,
 		Prices:      prices,
 		Medias:      medias,
+
+		AttachedCustomFields: attachedCustomFieldsToSDK(data.AttachedCustomFields),
+		OrganizationID:       optionalStringPointer(data.OrganizationID),
 	}
 
 	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
@@ -135,17 +141,34 @@ func buildProductUpdateRequest(ctx context.Context, data *ProductResourceModel,
 		update.Medias = medias
 	}
 
+	if data.AttachedCustomFields != nil {
+		update.AttachedCustomFields = attachedCustomFieldsToSDK(data.AttachedCustomFields)
+	}
+
 	isArchived := data.IsArchived.ValueBool()
 	update.IsArchived = &isArchived
 
 	return &update, diags
 }
 
+// buildProductClearPayload returns attached_custom_fields = [] when the plan
+// detaches every field, which ProductUpdate drops (see clearRemovedField), so
+// Update sends it through the supplemental client. An omitted list stays
+// unmanaged and is never cleared.
+func buildProductClearPayload(data *ProductResourceModel) map[string]any {
+	payload := map[string]any{}
+	if data.AttachedCustomFields != nil && len(data.AttachedCustomFields) == 0 {
+		payload["attached_custom_fields"] = []any{}
+	}
+	return payload
+}
+
 // --- Map SDK response to Terraform state ---
 
 // mapProductResponseToState converts the full product API response into the TF model.
 func mapProductResponseToState(ctx context.Context, product *components.Product, data *ProductResourceModel, diags *diag.Diagnostics) {
 	data.ID = types.StringValue(product.ID)
+	data.OrganizationID = types.StringValue(product.OrganizationID)
 	data.Name = types.StringValue(product.Name)
 	data.IsArchived = types.BoolValue(product.IsArchived)
 	data.Description = optionalStringValue(product.Description)
@@ -191,6 +214,45 @@ func mapProductResponseToState(ctx context.Context, product *components.Product,
 		diags.Append(d...)
 		data.Medias = mediaList
 	}
+
+	// Map attached_custom_fields — same opt-in pattern as benefit_ids.
+	if data.AttachedCustomFields != nil {
+		data.AttachedCustomFields = sdkAttachedCustomFieldsToModel(product.AttachedCustomFields)
+	}
+}
+
+// --- Custom field attachment helpers ---
+
+// attachedCustomFieldsToSDK converts the TF list to the SDK attach payload.
+// The API derives each field's order from its position in the list.
+func attachedCustomFieldsToSDK(fields []AttachedCustomFieldModel) []components.AttachedCustomFieldCreate {
+	if fields == nil {
+		return nil
+	}
+	result := make([]components.AttachedCustomFieldCreate, len(fields))
+	for i, f := range fields {
+		result[i] = components.AttachedCustomFieldCreate{
+			CustomFieldID: f.CustomFieldID.ValueString(),
+			Required:      f.Required.ValueBool(),
+		}
+	}
+	return result
+}
+
+// sdkAttachedCustomFieldsToModel converts the API response to the TF list, sorted by order.
+func sdkAttachedCustomFieldsToModel(fields []components.AttachedCustomField) []AttachedCustomFieldModel {
+	sorted := make([]components.AttachedCustomField, len(fields))
+	copy(sorted, fields)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Order < sorted[j].Order })
+
+	result := make([]AttachedCustomFieldModel, len(sorted))
+	for i, f := range sorted {
+		result[i] = AttachedCustomFieldModel{
+			CustomFieldID: types.StringValue(f.CustomFieldID),
+			Required:      types.BoolValue(f.Required),
+		}
+	}
+	return result
 }
 
 // --- Price conversion helpers ---
@@ -242,6 +304,21 @@ func buildMeteredUnitPriceCreate(p PriceModel) components.ProductPriceMeteredUni
 	return create
 }
 
+func buildSeatBasedPriceCreate(p PriceModel) components.ProductPriceSeatBasedCreate {
+	tiers := make([]components.ProductPriceSeatTier, len(p.SeatTiers))
+	for i, t := range p.SeatTiers {
+		tiers[i] = components.ProductPriceSeatTier{
+			MinSeats:     t.MinSeats.ValueInt64(),
+			MaxSeats:     optionalInt64Pointer(t.MaxSeats),
+			PricePerSeat: t.PricePerSeat.ValueInt64(),
+		}
+	}
+	return components.ProductPriceSeatBasedCreate{
+		PriceCurrency: optionalCurrency(p),
+		SeatTiers:     components.ProductPriceSeatTiers{Tiers: tiers},
+	}
+}
+
 func pricesToRecurringCreateSDK(prices []PriceModel) ([]components.ProductCreateRecurringPrices, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	result := make([]components.ProductCreateRecurringPrices, len(prices))
@@ -255,8 +332,10 @@ func pricesToRecurringCreateSDK(prices []PriceModel) ([]components.ProductCreate
 			result[i] = components.CreateProductCreateRecurringPricesCustom(buildCustomPriceCreate(p))
 		case "metered_unit":
 			result[i] = components.CreateProductCreateRecurringPricesMeteredUnit(buildMeteredUnitPriceCreate(p))
+		case "seat_based":
+			result[i] = components.CreateProductCreateRecurringPricesSeatBased(buildSeatBasedPriceCreate(p))
 		default:
-			diags.AddError("Unsupported price type", "Price amount_type must be one of: fixed, free, custom, metered_unit.")
+			diags.AddError("Unsupported price type", "Price amount_type must be one of: fixed, free, custom, metered_unit, seat_based.")
 			return nil, diags
 		}
 	}
@@ -276,8 +355,10 @@ func pricesToOneTimeCreateSDK(prices []PriceModel) ([]components.ProductCreateOn
 			result[i] = components.CreateProductCreateOneTimePricesCustom(buildCustomPriceCreate(p))
 		case "metered_unit":
 			result[i] = components.CreateProductCreateOneTimePricesMeteredUnit(buildMeteredUnitPriceCreate(p))
+		case "seat_based":
+			result[i] = components.CreateProductCreateOneTimePricesSeatBased(buildSeatBasedPriceCreate(p))
 		default:
-			diags.AddError("Unsupported price type", "Price amount_type must be one of: fixed, free, custom, metered_unit.")
+			diags.AddError("Unsupported price type", "Price amount_type must be one of: fixed, free, custom, metered_unit, seat_based.")
 			return nil, diags
 		}
 	}
@@ -316,6 +397,8 @@ func extractExistingPrices(apiPrices []components.Prices) []*existingPrice {
 			id = p.ProductPrice.ProductPriceCustom.ID
 		case p.ProductPrice.ProductPriceMeteredUnit != nil:
 			id = p.ProductPrice.ProductPriceMeteredUnit.ID
+		case p.ProductPrice.ProductPriceSeatBased != nil:
+			id = p.ProductPrice.ProductPriceSeatBased.ID
 		}
 		result = append(result, &existingPrice{id: id, data: *model})
 	}
@@ -351,10 +434,32 @@ func pricesMatch(planned, existing PriceModel) bool {
 			return false
 		}
 		return optionalInt64Equal(planned.CapAmount, existing.CapAmount)
+	case "seat_based":
+		if !planned.PriceCurrency.IsNull() && !planned.PriceCurrency.IsUnknown() &&
+			planned.PriceCurrency.ValueString() != existing.PriceCurrency.ValueString() {
+			return false
+		}
+		return seatTiersMatch(planned.SeatTiers, existing.SeatTiers)
 	}
 	return false
 }
 
+// seatTiersMatch compares two tier lists in order. Tier order is significant
+// since the API stores tiers as an ordered list.
+func seatTiersMatch(a, b []SeatTierModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].MinSeats.ValueInt64() != b[i].MinSeats.ValueInt64() ||
+			!optionalInt64Equal(a[i].MaxSeats, b[i].MaxSeats) ||
+			a[i].PricePerSeat.ValueInt64() != b[i].PricePerSeat.ValueInt64() {
+			return false
+		}
+	}
+	return true
+}
+
 func optionalInt64Equal(a, b types.Int64) bool {
 	if a.IsNull() && b.IsNull() {
 		return true
@@ -408,8 +513,12 @@ func pricesToUpdateSDK(planned []PriceModel, currentPrices []components.Prices)
 			result[i] = components.CreateProductUpdatePricesTwo(
 				components.CreateTwoMeteredUnit(buildMeteredUnitPriceCreate(p)),
 			)
+		case "seat_based":
+			result[i] = components.CreateProductUpdatePricesTwo(
+				components.CreateTwoSeatBased(buildSeatBasedPriceCreate(p)),
+			)
 		default:
-			diags.AddError("Unsupported price type", "Price amount_type must be one of: fixed, free, custom, metered_unit.")
+			diags.AddError("Unsupported price type", "Price amount_type must be one of: fixed, free, custom, metered_unit, seat_based.")
 			return nil, diags
 		}
 	}
@@ -514,6 +623,19 @@ func sdkProductPriceToModel(price *components.ProductPrice) *PriceModel {
 		m.CapAmount = optionalInt64Value(pp.CapAmount)
 		return &m
 
+	case price.ProductPriceSeatBased != nil:
+		pp := price.ProductPriceSeatBased
+		m := nullPriceModel("seat_based", types.StringValue(pp.PriceCurrency))
+		m.SeatTiers = make([]SeatTierModel, len(pp.SeatTiers.Tiers))
+		for i, t := range pp.SeatTiers.Tiers {
+			m.SeatTiers[i] = SeatTierModel{
+				MinSeats:     types.Int64Value(t.MinSeats),
+				MaxSeats:     optionalInt64Value(t.MaxSeats),
+				PricePerSeat: types.Int64Value(t.PricePerSeat),
+			}
+		}
+		return &m
+
 	default:
 		return nil
 	}
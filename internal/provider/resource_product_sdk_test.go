@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/polarsource/polar-go/models/components"
 )
 
 func typesStringNull() types.String { return types.StringNull() }
@@ -170,3 +171,108 @@ func TestPreserveUnitAmountFormatting_reorder(t *testing.T) {
 		t.Errorf("second price (m1): got %q, want %q", got, "0.50")
 	}
 }
+
+func TestSdkAttachedCustomFieldsToModel_sortsByOrder(t *testing.T) {
+	fields := []components.AttachedCustomField{
+		{CustomFieldID: "cf_b", Order: 1, Required: false},
+		{CustomFieldID: "cf_a", Order: 0, Required: true},
+	}
+
+	got := sdkAttachedCustomFieldsToModel(fields)
+	if len(got) != 2 {
+		t.Fatalf("got %d fields, want 2", len(got))
+	}
+	if got[0].CustomFieldID.ValueString() != "cf_a" || !got[0].Required.ValueBool() {
+		t.Errorf("first field = %s (required=%s), want cf_a (required=true)", got[0].CustomFieldID, got[0].Required)
+	}
+	if got[1].CustomFieldID.ValueString() != "cf_b" {
+		t.Errorf("second field = %s, want cf_b", got[1].CustomFieldID)
+	}
+	// The input slice must not be reordered.
+	if fields[0].CustomFieldID != "cf_b" {
+		t.Error("input slice was mutated")
+	}
+}
+
+func TestAttachedCustomFieldsToSDK_nilStaysNil(t *testing.T) {
+	if got := attachedCustomFieldsToSDK(nil); got != nil {
+		t.Errorf("attachedCustomFieldsToSDK(nil) = %v, want nil", got)
+	}
+}
+
+// seatPrice builds a seat_based PriceModel for testing.
+func seatPrice(tiers ...SeatTierModel) PriceModel {
+	m := nullPriceModel("seat_based", types.StringNull())
+	m.SeatTiers = tiers
+	return m
+}
+
+func seatTier(minSeats int64, maxSeats types.Int64, pricePerSeat int64) SeatTierModel {
+	return SeatTierModel{
+		MinSeats:     types.Int64Value(minSeats),
+		MaxSeats:     maxSeats,
+		PricePerSeat: types.Int64Value(pricePerSeat),
+	}
+}
+
+func TestPricesMatch_seatBased(t *testing.T) {
+	existing := seatPrice(seatTier(1, types.Int64Value(10), 1000), seatTier(11, types.Int64Null(), 800))
+	existing.PriceCurrency = types.StringValue("usd")
+
+	tests := []struct {
+		name    string
+		planned PriceModel
+		want    bool
+	}{
+		{"identical tiers", seatPrice(seatTier(1, types.Int64Value(10), 1000), seatTier(11, types.Int64Null(), 800)), true},
+		{"different price per seat", seatPrice(seatTier(1, types.Int64Value(10), 1000), seatTier(11, types.Int64Null(), 700)), false},
+		{"bounded vs unbounded last tier", seatPrice(seatTier(1, types.Int64Value(10), 1000), seatTier(11, types.Int64Value(50), 800)), false},
+		{"fewer tiers", seatPrice(seatTier(1, types.Int64Null(), 1000)), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pricesMatch(tt.planned, existing); got != tt.want {
+				t.Errorf("pricesMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSdkProductPriceToModel_seatBased(t *testing.T) {
+	maxSeats := int64(10)
+	price := components.CreateProductPriceSeatBased(components.ProductPriceSeatBased{
+		ID:            "price_1",
+		PriceCurrency: "usd",
+		SeatTiers: components.ProductPriceSeatTiers{
+			Tiers: []components.ProductPriceSeatTier{
+				{MinSeats: 1, MaxSeats: &maxSeats, PricePerSeat: 1000},
+				{MinSeats: 11, PricePerSeat: 800},
+			},
+		},
+	})
+
+	got := sdkProductPriceToModel(&price)
+	if got == nil {
+		t.Fatal("sdkProductPriceToModel() = nil")
+	}
+	if got.AmountType.ValueString() != "seat_based" {
+		t.Errorf("amount_type = %q, want %q", got.AmountType.ValueString(), "seat_based")
+	}
+	if !got.PriceAmount.IsNull() {
+		t.Errorf("price_amount = %s, want null", got.PriceAmount)
+	}
+	want := []SeatTierModel{
+		seatTier(1, types.Int64Value(10), 1000),
+		seatTier(11, types.Int64Null(), 800),
+	}
+	if !seatTiersMatch(got.SeatTiers, want) {
+		t.Errorf("seat_tiers = %v, want %v", got.SeatTiers, want)
+	}
+
+	// The extracted ID must be reusable for updates.
+	existing := extractExistingPrices([]components.Prices{{ProductPrice: &price}})
+	if len(existing) != 1 || existing[0].id != "price_1" {
+		t.Errorf("extractExistingPrices() did not return the seat-based price ID")
+	}
+}
@@ -4,8 +4,12 @@ package provider
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -235,6 +239,78 @@ func TestAccProductResource_meteredUnit(t *testing.T) {
 	})
 }
 
+func TestAccProductResource_seatBased(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProductSeatBasedConfig(rName, 1000),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_product.test",
+						tfjsonpath.New("prices").AtSliceIndex(0).AtMapKey("amount_type"),
+						knownvalue.StringExact("seat_based"),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_product.test",
+						tfjsonpath.New("prices").AtSliceIndex(0).AtMapKey("seat_tiers"),
+						knownvalue.ListSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_product.test",
+						tfjsonpath.New("prices").AtSliceIndex(0).AtMapKey("seat_tiers").AtSliceIndex(1).AtMapKey("max_seats"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			// ImportState
+			{
+				ResourceName:      "polar_product.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Change the first tier's price, which replaces the price
+			{
+				Config: testAccProductSeatBasedConfig(rName, 1200),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_product.test",
+						tfjsonpath.New("prices").AtSliceIndex(0).AtMapKey("seat_tiers").AtSliceIndex(0).AtMapKey("price_per_seat"),
+						knownvalue.Int64Exact(1200),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestValidateSeatTiers(t *testing.T) {
+	tests := []struct {
+		name    string
+		tiers   []SeatTierModel
+		wantErr bool
+	}{
+		{"single unbounded tier", []SeatTierModel{seatTier(1, types.Int64Null(), 1000)}, false},
+		{"contiguous tiers", []SeatTierModel{seatTier(1, types.Int64Value(10), 1000), seatTier(11, types.Int64Null(), 800)}, false},
+		{"gap between tiers", []SeatTierModel{seatTier(1, types.Int64Value(10), 1000), seatTier(12, types.Int64Null(), 800)}, true},
+		{"overlapping tiers", []SeatTierModel{seatTier(1, types.Int64Value(10), 1000), seatTier(10, types.Int64Null(), 800)}, true},
+		{"unbounded tier not last", []SeatTierModel{seatTier(1, types.Int64Null(), 1000), seatTier(11, types.Int64Null(), 800)}, true},
+		{"max below min", []SeatTierModel{seatTier(5, types.Int64Value(4), 1000)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateSeatTiers(tt.tiers, path.Root("seat_tiers"), &diags)
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("validateSeatTiers() errors = %v, wantErr %v", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestAccProductResource_withBenefits(t *testing.T) {
 	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
 	resource.Test(t, resource.TestCase{
@@ -268,6 +344,137 @@ func TestAccProductResource_withBenefits(t *testing.T) {
 	})
 }
 
+func TestAccProductResource_withCustomFields(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create product with a required custom field
+			{
+				Config: testAccProductWithCustomFieldsConfig(rName, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_product.test",
+						tfjsonpath.New("attached_custom_fields"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_product.test",
+						tfjsonpath.New("attached_custom_fields").AtSliceIndex(0).AtMapKey("required"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// Make the field optional in place
+			{
+				Config: testAccProductWithCustomFieldsConfig(rName, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_product.test",
+						tfjsonpath.New("attached_custom_fields").AtSliceIndex(0).AtMapKey("required"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			// Detach every field with an explicit empty list
+			{
+				Config: testAccProductWithoutCustomFieldsConfig(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_product.test",
+						tfjsonpath.New("attached_custom_fields"),
+						knownvalue.ListSizeExact(0),
+					),
+				},
+			},
+			// ImportState — attached_custom_fields is null after import (unmanaged until configured)
+			{
+				ResourceName:            "polar_product.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attached_custom_fields"},
+			},
+		},
+	})
+}
+
+func TestBuildProductClearPayload(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []AttachedCustomFieldModel
+		want   map[string]any
+	}{
+		{name: "unmanaged", fields: nil, want: map[string]any{}},
+		{
+			name:   "attached",
+			fields: []AttachedCustomFieldModel{{CustomFieldID: types.StringValue("cf_1"), Required: types.BoolValue(true)}},
+			want:   map[string]any{},
+		},
+		{name: "detached", fields: []AttachedCustomFieldModel{}, want: map[string]any{"attached_custom_fields": []any{}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := ProductResourceModel{AttachedCustomFields: tt.fields}
+			if got := buildProductClearPayload(&data); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildProductClearPayload() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestProductResource_offline runs the product lifecycle, including a price
+// change and benefit attachment, against the polartest fake.
+func TestProductResource_offline(t *testing.T) {
+	_, factories := testFakeProviderFactories(t)
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProviderConfig + testAccProductOneTimeFixedConfig("offline", 1000),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_product.test",
+						tfjsonpath.New("prices").AtSliceIndex(0).AtMapKey("price_amount"),
+						knownvalue.Int64Exact(1000),
+					),
+				},
+			},
+			{
+				ResourceName:      "polar_product.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testFakeProviderConfig + testAccProductWithDescriptionConfig("offline-updated", "Updated offline", 2000),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_product.test",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("Updated offline"),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_product.test",
+						tfjsonpath.New("prices").AtSliceIndex(0).AtMapKey("price_amount"),
+						knownvalue.Int64Exact(2000),
+					),
+				},
+			},
+			{
+				Config: testFakeProviderConfig + testAccProductWithBenefitsConfig("offline-benefits"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_product.test",
+						tfjsonpath.New("benefit_ids"),
+						knownvalue.SetSizeExact(1),
+					),
+				},
+			},
+		},
+	})
+}
+
 // --- Config helpers ---
 
 func testAccProductOneTimeFixedConfig(name string, priceAmount int64) string {
@@ -359,6 +566,23 @@ resource "polar_product" "test" {
 `, meterName, name, unitAmount, capAmount)
 }
 
+func testAccProductSeatBasedConfig(name string, firstTierPrice int64) string {
+	return fmt.Sprintf(`
+resource "polar_product" "test" {
+  name               = %q
+  recurring_interval = "month"
+
+  prices = [{
+    amount_type = "seat_based"
+    seat_tiers = [
+      { min_seats = 1, max_seats = 10, price_per_seat = %d },
+      { min_seats = 11, price_per_seat = 800 },
+    ]
+  }]
+}
+`, name, firstTierPrice)
+}
+
 func testAccProductWithMetadataConfig(name, metadata string) string {
 	return fmt.Sprintf(`
 resource "polar_product" "test" {
@@ -416,3 +640,50 @@ resource "polar_product" "test" {
 }
 `, name)
 }
+
+func testAccProductWithCustomFieldsConfig(name string, required bool) string {
+	return fmt.Sprintf(`
+resource "polar_custom_field" "test" {
+  type = "text"
+  slug = lower(%[1]q)
+  name = "Company"
+}
+
+resource "polar_product" "test" {
+  name = %[1]q
+
+  prices = [{
+    amount_type  = "fixed"
+    price_amount = 500
+  }]
+
+  attached_custom_fields = [{
+    custom_field_id = polar_custom_field.test.id
+    required        = %[2]t
+  }]
+}
+`, name, required)
+}
+
+// testAccProductWithoutCustomFieldsConfig keeps the custom field from
+// testAccProductWithCustomFieldsConfig but detaches it from the product.
+func testAccProductWithoutCustomFieldsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "polar_custom_field" "test" {
+  type = "text"
+  slug = lower(%[1]q)
+  name = "Company"
+}
+
+resource "polar_product" "test" {
+  name = %[1]q
+
+  prices = [{
+    amount_type  = "fixed"
+    price_amount = 500
+  }]
+
+  attached_custom_fields = []
+}
+`, name)
+}
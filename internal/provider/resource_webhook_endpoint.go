@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -32,17 +34,28 @@ func NewWebhookEndpointResource() resource.Resource {
 }
 
 type WebhookEndpointResource struct {
-	client *polargo.Polar // set in Configure()
+	client         *polargo.Polar    // set in Configure()
+	organizationID string            // provider default, see resolveOrganizationID
+	consistency    consistencyPolicy // provider consistency settings, see pollForConsistency
+	retry          retryPolicy       // provider retry settings, see retryPolicy.createOption
 }
 
 // WebhookEndpointResourceModel is the Terraform state shape for polar_webhook_endpoint.
+// SecretWO is write-only: it is only ever populated from config, never from
+// plan or state.
 type WebhookEndpointResourceModel struct {
-	ID      types.String `tfsdk:"id"`
-	URL     types.String `tfsdk:"url"`
-	Format  types.String `tfsdk:"format"`
-	Events  types.Set    `tfsdk:"events"`
-	Secret  types.String `tfsdk:"secret"`
-	Enabled types.Bool   `tfsdk:"enabled"`
+	ID                  types.String `tfsdk:"id"`
+	OrganizationID      types.String `tfsdk:"organization_id"`
+	URL                 types.String `tfsdk:"url"`
+	Format              types.String `tfsdk:"format"`
+	Events              types.Set    `tfsdk:"events"`
+	Secret              types.String `tfsdk:"secret"`
+	SecretWO            types.String `tfsdk:"secret_wo"`
+	SecretWOVersion     types.Int64  `tfsdk:"secret_wo_version"`
+	RotateSecretTrigger types.String `tfsdk:"rotate_secret_trigger"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+
+	Consistency *ConsistencyOverrideModel `tfsdk:"consistency"`
 }
 
 func (r *WebhookEndpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -61,6 +74,7 @@ func (r *WebhookEndpointResource) Schema(ctx context.Context, req resource.Schem
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"organization_id": organizationIDAttribute("webhook endpoint"),
 			"url": schema.StringAttribute{
 				MarkdownDescription: "The URL where webhook events will be sent. Must use HTTPS.",
 				Required:            true,
@@ -79,16 +93,50 @@ func (r *WebhookEndpointResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"events": schema.SetAttribute{
-				MarkdownDescription: "The set of event types this endpoint subscribes to.",
+				MarkdownDescription: "The set of event types this endpoint subscribes to, e.g. `order.paid`. Use the `polar_webhook_event_types` data source to subscribe to every event matching a pattern.",
 				Required:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(webhookEventTypeValidator{}),
+				},
 			},
 			"secret": schema.StringAttribute{
-				MarkdownDescription: "The HMAC secret used to sign webhook payloads. Generated by the server on creation.",
-				Computed:            true,
-				Sensitive:           true,
+				MarkdownDescription: "The HMAC secret used to sign webhook payloads. Generated by the server on creation. " +
+					"Null when the secret is supplied through `secret_wo`, so it never lands in state.",
+				Computed:  true,
+				Sensitive: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					unknownWhenChanged(path.Root("rotate_secret_trigger"), path.Root("secret_wo_version")),
+				},
+			},
+			"secret_wo": schema.StringAttribute{
+				MarkdownDescription: "A secret to sign webhook payloads with, supplied by you instead of generated by Polar. " +
+					"Write-only: it is sent to the API but never stored in plan or state, and `secret` is left null. " +
+					"Typically fed from an ephemeral value that is also written to your secret manager. " +
+					"Only sent on create and when `secret_wo_version` changes. Requires Terraform 1.11 or later.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("secret_wo_version")),
+				},
+			},
+			"secret_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `secret_wo`. Terraform can't see changes to write-only values, so bump this to send a new `secret_wo`. " +
+					"Removing both attributes resets the endpoint to a new Polar-generated secret, stored in `secret`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("secret_wo")),
+				},
+			},
+			"rotate_secret_trigger": schema.StringAttribute{
+				MarkdownDescription: "Any string. Changing it after creation resets the server-generated secret, e.g. set it from a `time_rotating` resource to rotate on a schedule. " +
+					"Conflicts with `secret_wo`; rotate a write-only secret by bumping `secret_wo_version`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("secret_wo")),
 				},
 			},
 			"enabled": schema.BoolAttribute{
@@ -97,6 +145,7 @@ func (r *WebhookEndpointResource) Schema(ctx context.Context, req resource.Schem
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
 			},
+			"consistency": consistencyOverrideAttribute(),
 		},
 	}
 }
@@ -104,10 +153,14 @@ func (r *WebhookEndpointResource) Schema(ctx context.Context, req resource.Schem
 func (r *WebhookEndpointResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if pd := extractProviderData(req.ProviderData, &resp.Diagnostics); pd != nil {
 		r.client = pd.Client
+		r.consistency = pd.Consistency
+		r.retry = pd.Retry
+		r.organizationID = pd.OrganizationID
 	}
 }
 
 func (r *WebhookEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withCorrelationID(ctx)
 	var data WebhookEndpointResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -126,13 +179,23 @@ func (r *WebhookEndpointResource) Create(ctx context.Context, req resource.Creat
 		events[i] = components.WebhookEventType(e)
 	}
 
+	// Write-only values are only available from config, not the plan.
+	var secretWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_wo"), &secretWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrganizationID = resolveOrganizationID(data.OrganizationID, r.organizationID)
 	createReq := components.WebhookEndpointCreate{
-		URL:    data.URL.ValueString(),
-		Format: components.WebhookFormat(data.Format.ValueString()),
-		Events: events,
+		URL:            data.URL.ValueString(),
+		Secret:         optionalStringPointer(secretWO),
+		Format:         components.WebhookFormat(data.Format.ValueString()),
+		Events:         events,
+		OrganizationID: optionalStringPointer(data.OrganizationID),
 	}
 
-	result, err := r.client.Webhooks.CreateWebhookEndpoint(ctx, createReq)
+	result, err := r.client.Webhooks.CreateWebhookEndpoint(ctx, createReq, r.retry.createOption())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating webhook endpoint",
@@ -169,7 +232,7 @@ func (r *WebhookEndpointResource) Create(ctx context.Context, req resource.Creat
 	// response (not local clock) and poll GET until the response timestamp
 	// catches up, confirming the write has propagated across replicas.
 	writeTime := latestTimestamp(endpoint)
-	webhook, err := pollForConsistency(ctx, "webhook endpoint", endpoint.ID, writeTime, func() (*components.WebhookEndpoint, error) {
+	webhook, err := pollForConsistency(ctx, r.consistency.withOverride(data.Consistency), "webhook endpoint", endpoint.ID, writeTime, func() (*components.WebhookEndpoint, error) {
 		result, err := r.client.Webhooks.GetWebhookEndpoint(ctx, endpoint.ID)
 		if err != nil {
 			return nil, err
@@ -192,6 +255,7 @@ func (r *WebhookEndpointResource) Create(ctx context.Context, req resource.Creat
 // Read refreshes TF state from the API. If the resource was deleted out-of-band
 // (404), it's gracefully removed from state so Terraform knows to recreate it.
 func (r *WebhookEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = withCorrelationID(ctx)
 	var data WebhookEndpointResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -214,10 +278,13 @@ func (r *WebhookEndpointResource) Read(ctx context.Context, req resource.ReadReq
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-// Update: plan → build SDK request → call API → poll for consistency → save state.
+// Update: plan → build SDK request → call API → reset secret if triggered or
+// secret_wo was removed → poll for consistency → save state.
 func (r *WebhookEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data WebhookEndpointResourceModel
+	ctx = withCorrelationID(ctx)
+	var data, state WebhookEndpointResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -244,6 +311,17 @@ func (r *WebhookEndpointResource) Update(ctx context.Context, req resource.Updat
 		Enabled: &enabled,
 	}
 
+	// A new secret_wo is only sent when its version changes, since Terraform
+	// can't diff write-only values.
+	if !data.SecretWOVersion.Equal(state.SecretWOVersion) {
+		var secretWO types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("secret_wo"), &secretWO)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.Secret = optionalStringPointer(secretWO)
+	}
+
 	updateResult, err := r.client.Webhooks.UpdateWebhookEndpoint(ctx, data.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -253,10 +331,29 @@ func (r *WebhookEndpointResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	// Eventual consistency poll (same pattern as Create).
+	endpoint := updateResult.WebhookEndpoint
+
+	// Reset the secret when the trigger changes, and when secret_wo is
+	// removed: the endpoint still holds the caller's write-only secret, which
+	// must not be copied into state once secret_wo_version is null.
 	webhookID := data.ID.ValueString()
-	writeTime := latestTimestamp(updateResult.WebhookEndpoint)
-	webhook, err := pollForConsistency(ctx, "webhook endpoint", webhookID, writeTime, func() (*components.WebhookEndpoint, error) {
+	writeOnlyRemoved := data.SecretWOVersion.IsNull() && !state.SecretWOVersion.IsNull()
+	if !data.RotateSecretTrigger.Equal(state.RotateSecretTrigger) || writeOnlyRemoved {
+		resetResult, err := r.client.Webhooks.ResetWebhookEndpointSecret(ctx, webhookID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error resetting webhook endpoint secret",
+				fmt.Sprintf("Webhook endpoint %s was updated but its secret could not be reset: %s", webhookID, err),
+			)
+			return
+		}
+		tflog.Trace(ctx, "reset webhook endpoint secret", map[string]interface{}{"id": webhookID})
+		endpoint = resetResult.WebhookEndpoint
+	}
+
+	// Eventual consistency poll (same pattern as Create).
+	writeTime := latestTimestamp(endpoint)
+	webhook, err := pollForConsistency(ctx, r.consistency.withOverride(data.Consistency), "webhook endpoint", webhookID, writeTime, func() (*components.WebhookEndpoint, error) {
 		result, err := r.client.Webhooks.GetWebhookEndpoint(ctx, webhookID)
 		if err != nil {
 			return nil, err
@@ -278,6 +375,7 @@ func (r *WebhookEndpointResource) Update(ctx context.Context, req resource.Updat
 // Delete performs a real DELETE (unlike meters/products which archive).
 // If the resource was already deleted out-of-band (404), that's a no-op.
 func (r *WebhookEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withCorrelationID(ctx)
 	var data WebhookEndpointResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -308,9 +406,15 @@ func (r *WebhookEndpointResource) ImportState(ctx context.Context, req resource.
 // mapResponseToState maps a WebhookEndpoint API response to the Terraform resource model.
 func (r *WebhookEndpointResource) mapResponseToState(_ context.Context, endpoint *components.WebhookEndpoint, data *WebhookEndpointResourceModel, diags *diag.Diagnostics) {
 	data.ID = types.StringValue(endpoint.ID)
+	data.OrganizationID = types.StringValue(endpoint.OrganizationID)
 	data.URL = types.StringValue(endpoint.URL)
 	data.Format = types.StringValue(string(endpoint.Format))
-	data.Secret = types.StringValue(endpoint.Secret)
+	// A write-only secret is the caller's to keep; don't copy it into state.
+	if data.SecretWOVersion.IsNull() {
+		data.Secret = types.StringValue(endpoint.Secret)
+	} else {
+		data.Secret = types.StringNull()
+	}
 	data.Enabled = types.BoolValue(endpoint.Enabled)
 
 	// Convert events to Terraform set
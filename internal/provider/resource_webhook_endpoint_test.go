@@ -3,15 +3,24 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/sjkchang/terraform-provider-polar/internal/polartest"
 )
 
 func TestAccWebhookEndpointResource_basic(t *testing.T) {
@@ -99,6 +108,76 @@ func TestAccWebhookEndpointResource_disabled(t *testing.T) {
 	})
 }
 
+func TestAccWebhookEndpointResource_rotateSecret(t *testing.T) {
+	rSuffix := acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	webhookURL := fmt.Sprintf("https://example.com/webhook/tf-acc-%s-rotate", rSuffix)
+	secretChanges := statecheck.CompareValue(compare.ValuesDiffer())
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookEndpointConfigWithRotation(webhookURL, "v1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					secretChanges.AddStateValue("polar_webhook_endpoint.test", tfjsonpath.New("secret")),
+				},
+			},
+			// Changing the trigger resets the secret
+			{
+				Config: testAccWebhookEndpointConfigWithRotation(webhookURL, "v2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					secretChanges.AddStateValue("polar_webhook_endpoint.test", tfjsonpath.New("secret")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccWebhookEndpointResource_writeOnlySecret(t *testing.T) {
+	rSuffix := acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	webhookURL := fmt.Sprintf("https://example.com/webhook/tf-acc-%s-wo", rSuffix)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookEndpointConfigWithWriteOnlySecret(webhookURL, "tf-acc-secret-"+rSuffix+"-0000000000000000", 1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_webhook_endpoint.test",
+						tfjsonpath.New("secret"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_webhook_endpoint.test",
+						tfjsonpath.New("secret_wo"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			// Bumping the version sends the new secret; it still stays out of state
+			{
+				Config: testAccWebhookEndpointConfigWithWriteOnlySecret(webhookURL, "tf-acc-secret-"+rSuffix+"-1111111111111111", 2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"polar_webhook_endpoint.test",
+						tfjsonpath.New("secret"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"polar_webhook_endpoint.test",
+						tfjsonpath.New("secret_wo_version"),
+						knownvalue.Int64Exact(2),
+					),
+				},
+			},
+		},
+	})
+}
+
 func TestWebhookEndpointResource_rejectsHTTP(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -111,6 +190,127 @@ func TestWebhookEndpointResource_rejectsHTTP(t *testing.T) {
 	})
 }
 
+func TestWebhookEndpointResource_eventTypeValidation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWebhookEndpointConfig("https://example.com/webhook", "raw", `["subscription.cancelled"]`),
+				ExpectError: regexp.MustCompile(`Did you mean "subscription.canceled"\?`),
+			},
+		},
+	})
+}
+
+// TestWebhookEndpointResource_removeWriteOnlySecret checks that removing
+// secret_wo and secret_wo_version resets the secret, so the caller's
+// write-only secret never reaches state.
+func TestWebhookEndpointResource_removeWriteOnlySecret(t *testing.T) {
+	ctx := context.Background()
+	server := polartest.NewServer()
+	defer server.Close()
+
+	t.Setenv("POLAR_SERVER", "")
+	t.Setenv("POLAR_ORGANIZATION_ID", "")
+	pd, diags := configureTestProvider(t, map[string]any{
+		"access_token": "polar_oat_test",
+		"base_url":     server.URL(),
+	})
+	if diags.HasError() {
+		t.Fatalf("Configure: %v", diags)
+	}
+
+	const writeOnlySecret = "polar_whs_write_only_secret"
+	endpoint, err := supplementalPost[map[string]any](ctx, pd.Supplemental, "/v1/webhooks/endpoints", map[string]any{
+		"url":    "https://example.com/webhook",
+		"format": "raw",
+		"events": []any{"order.created"},
+		"secret": writeOnlySecret,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewWebhookEndpointResource()
+	var configureResp fwresource.ConfigureResponse
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: pd}, &configureResp)
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(ctx)
+
+	prior := WebhookEndpointResourceModel{
+		ID:                  types.StringValue((*endpoint)["id"].(string)),
+		OrganizationID:      types.StringValue((*endpoint)["organization_id"].(string)),
+		URL:                 types.StringValue("https://example.com/webhook"),
+		Format:              types.StringValue("raw"),
+		Events:              types.SetValueMust(types.StringType, []attr.Value{types.StringValue("order.created")}),
+		Secret:              types.StringNull(),
+		SecretWO:            types.StringNull(),
+		SecretWOVersion:     types.Int64Value(1),
+		RotateSecretTrigger: types.StringNull(),
+		Enabled:             types.BoolValue(true),
+	}
+	planned := prior
+	planned.SecretWOVersion = types.Int64Null()
+	planned.Secret = types.StringUnknown()
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)}
+	if d := state.Set(ctx, &prior); d.HasError() {
+		t.Fatalf("state: %v", d)
+	}
+	if d := plan.Set(ctx, &planned); d.HasError() {
+		t.Fatalf("plan: %v", d)
+	}
+	resp := fwresource.UpdateResponse{State: state}
+	r.Update(ctx, fwresource.UpdateRequest{
+		Plan:   plan,
+		State:  state,
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", resp.Diagnostics)
+	}
+
+	var got WebhookEndpointResourceModel
+	if d := resp.State.Get(ctx, &got); d.HasError() {
+		t.Fatalf("decoding state: %v", d)
+	}
+	if got.Secret.IsNull() || got.Secret.ValueString() == writeOnlySecret {
+		t.Errorf("secret = %s, want a newly generated secret", got.Secret)
+	}
+}
+
+// TestWebhookEndpointResource_offline runs the webhook endpoint lifecycle,
+// including a secret rotation, against the polartest fake.
+func TestWebhookEndpointResource_offline(t *testing.T) {
+	_, factories := testFakeProviderFactories(t)
+	secretChanges := statecheck.CompareValue(compare.ValuesDiffer())
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: factories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProviderConfig + testAccWebhookEndpointConfigWithRotation("https://example.com/webhook/offline", "v1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					secretChanges.AddStateValue("polar_webhook_endpoint.test", tfjsonpath.New("secret")),
+				},
+			},
+			{
+				ResourceName:            "polar_webhook_endpoint.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret", "rotate_secret_trigger"},
+			},
+			{
+				Config: testFakeProviderConfig + testAccWebhookEndpointConfigWithRotation("https://example.com/webhook/offline", "v2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					secretChanges.AddStateValue("polar_webhook_endpoint.test", tfjsonpath.New("secret")),
+				},
+			},
+		},
+	})
+}
+
 func testAccWebhookEndpointConfig(url, format, events string) string {
 	return fmt.Sprintf(`
 resource "polar_webhook_endpoint" "test" {
@@ -131,3 +331,26 @@ resource "polar_webhook_endpoint" "test" {
 }
 `, url, format, events, enabled)
 }
+
+func testAccWebhookEndpointConfigWithRotation(url, trigger string) string {
+	return fmt.Sprintf(`
+resource "polar_webhook_endpoint" "test" {
+  url                   = %[1]q
+  format                = "raw"
+  events                = ["order.created"]
+  rotate_secret_trigger = %[2]q
+}
+`, url, trigger)
+}
+
+func testAccWebhookEndpointConfigWithWriteOnlySecret(url, secret string, version int) string {
+	return fmt.Sprintf(`
+resource "polar_webhook_endpoint" "test" {
+  url               = %[1]q
+  format            = "raw"
+  events            = ["order.created"]
+  secret_wo         = %[2]q
+  secret_wo_version = %[3]d
+}
+`, url, secret, version)
+}
@@ -0,0 +1,319 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// --- Supplemental API client ---
+// polar-go v0.12.0 lags the API: some endpoints (organization access tokens)
+// and fields (organization settings) are missing. supplementalClient fills
+// those gaps with raw JSON requests that share the SDK's base URL, HTTP
+// client (and so its User-Agent and request logging) and retry policy, so any
+// resource can use it the same way.
+
+// supplementalClient sends JSON requests to the Polar API outside the SDK.
+type supplementalClient struct {
+	baseURL     string // e.g. "https://api.polar.sh", without a trailing slash
+	accessToken string
+	httpClient  *http.Client
+	retry       retryPolicy
+}
+
+func newSupplementalClient(baseURL, accessToken string, httpClient *http.Client, retry retryPolicy) *supplementalClient {
+	return &supplementalClient{
+		baseURL:     baseURL,
+		accessToken: accessToken,
+		httpClient:  httpClient,
+		retry:       retry,
+	}
+}
+
+// supplementalGet sends a GET to path (e.g. "/v1/organizations/{id}") and
+// decodes the response.
+func supplementalGet[T any](ctx context.Context, c *supplementalClient, path string) (*T, error) {
+	var result T
+	if err := c.do(ctx, http.MethodGet, path, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// supplementalPatch sends payload as a PATCH to path and decodes the response.
+func supplementalPatch[T any](ctx context.Context, c *supplementalClient, path string, payload any) (*T, error) {
+	var result T
+	if err := c.do(ctx, http.MethodPatch, path, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// supplementalPost sends payload as a POST to path and decodes the response.
+func supplementalPost[T any](ctx context.Context, c *supplementalClient, path string, payload any) (*T, error) {
+	var result T
+	if err := c.do(ctx, http.MethodPost, path, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// do sends a request with retry. payload, if non-nil, is sent as the JSON
+// body, and a successful response body is decoded into out unless out is nil
+// or the body is empty. Failed responses return a *supplementalAPIError.
+func (c *supplementalClient) do(ctx context.Context, method, path string, payload, out any) error {
+	var body []byte
+	if payload != nil {
+		var err error
+		body, err = json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("marshaling request: %w", err)
+		}
+	}
+
+	ctx = tflog.MaskAllFieldValuesRegexes(ctx, polarSecretPattern)
+	ctx = tflog.SetField(ctx, "method", method)
+	ctx = tflog.SetField(ctx, "path", path)
+
+	// A POST that fails before a response arrives may still have created
+	// something (e.g. minted an access token), so retrying it could create a
+	// duplicate. Only GET, PATCH and DELETE retry connection errors.
+	policy := c.retry
+	if method == http.MethodPost {
+		policy.RetryConnectionErrors = false
+	}
+
+	return doWithRetry(ctx, policy, func() (*http.Response, error) {
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(body)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("creating request: %w", err)
+		}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		// On success, decode and let doWithRetry know the body is handled.
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			respBody, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if readErr != nil {
+				return nil, fmt.Errorf("reading response body: %w", readErr)
+			}
+			if out != nil && len(bytes.TrimSpace(respBody)) > 0 {
+				if decodeErr := json.Unmarshal(respBody, out); decodeErr != nil {
+					return nil, fmt.Errorf("decoding response: %w", decodeErr)
+				}
+			}
+			return nil, nil
+		}
+		return resp, nil
+	})
+}
+
+// polarSecretPattern matches Polar access tokens and webhook secrets
+// (polar_oat_..., polar_pat_..., polar_whs_...), which are masked in logs.
+var polarSecretPattern = regexp.MustCompile(`polar_[a-z]+_[A-Za-z0-9]+`)
+
+// supplementalAPIError is a failed supplemental request, with Polar's error
+// body decoded.
+type supplementalAPIError struct {
+	StatusCode int
+	// Type is Polar's error name, e.g. "ResourceNotFound", if the body has one.
+	Type string
+	// Detail is the error detail, with validation errors joined into one line.
+	Detail string
+}
+
+func (e *supplementalAPIError) Error() string {
+	msg := fmt.Sprintf("supplemental HTTP request failed with status %d", e.StatusCode)
+	if e.Type != "" {
+		msg += " (" + e.Type + ")"
+	}
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	return msg
+}
+
+// decodeSupplementalError decodes Polar's error bodies: {"error": ...,
+// "detail": "..."} for most errors, and {"detail": [{"loc": [...], "msg": ...}]}
+// for validation errors. Anything else leaves only the status.
+func decodeSupplementalError(statusCode int, body []byte) *supplementalAPIError {
+	apiErr := &supplementalAPIError{StatusCode: statusCode}
+	var parsed struct {
+		Error  string          `json:"error"`
+		Detail json.RawMessage `json:"detail"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return apiErr
+	}
+	apiErr.Type = parsed.Error
+
+	var detail string
+	if err := json.Unmarshal(parsed.Detail, &detail); err == nil {
+		apiErr.Detail = detail
+		return apiErr
+	}
+	var validation []struct {
+		Loc []any  `json:"loc"`
+		Msg string `json:"msg"`
+	}
+	if err := json.Unmarshal(parsed.Detail, &validation); err == nil {
+		messages := make([]string, 0, len(validation))
+		for _, v := range validation {
+			// Drop the leading "body" from locations like ["body", "name"].
+			var loc []string
+			for i, part := range v.Loc {
+				if i == 0 && part == "body" {
+					continue
+				}
+				loc = append(loc, fmt.Sprint(part))
+			}
+			if len(loc) == 0 {
+				messages = append(messages, v.Msg)
+				continue
+			}
+			messages = append(messages, strings.Join(loc, ".")+": "+v.Msg)
+		}
+		apiErr.Detail = strings.Join(messages, "; ")
+	}
+	return apiErr
+}
+
+// doWithRetry executes fn with exponential backoff on 429 (rate limit) and 5xx
+// (server errors). If fn returns (nil, nil), it means the caller already consumed
+// and closed the response body on success (see supplementalClient.do).
+// Respects Retry-After headers when present on 429 responses. Connection
+// errors are retried too when policy.RetryConnectionErrors is set.
+func doWithRetry(ctx context.Context, policy retryPolicy, fn func() (*http.Response, error)) error {
+	clk := clockOrSystem(policy.Clock)
+	start := clk.Now()
+	for attempt := 0; ; attempt++ {
+		resp, err := fn()
+		if err != nil {
+			if policy.RetryConnectionErrors && isConnectionError(ctx, err) {
+				backoff := retryBackoff(nil, attempt, policy)
+				if clk.Now().Sub(start)+backoff <= policy.MaxElapsed {
+					tflog.Debug(ctx, "retrying supplemental HTTP request after connection error", map[string]interface{}{
+						"error":   err.Error(),
+						"attempt": attempt + 1,
+						"backoff": backoff.String(),
+					})
+					select {
+					case <-ctx.Done():
+						return ctx.Err()
+					case <-clk.After(backoff):
+						continue
+					}
+				}
+			}
+			return err
+		}
+
+		// nil response means caller already handled a successful response
+		if resp == nil {
+			return nil
+		}
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			resp.Body.Close()
+			return nil
+		}
+
+		respBody, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil {
+			respBody = []byte("(failed to read response body)")
+		}
+
+		// Retry on 429 or 5xx if we haven't exceeded the max elapsed time.
+		if resp.StatusCode == 429 || resp.StatusCode >= 500 {
+			backoff := retryBackoff(resp, attempt, policy)
+			if clk.Now().Sub(start)+backoff <= policy.MaxElapsed {
+				tflog.Debug(ctx, "retrying supplemental HTTP request", map[string]interface{}{
+					"status":  resp.StatusCode,
+					"attempt": attempt + 1,
+					"backoff": backoff.String(),
+				})
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-clk.After(backoff):
+					continue
+				}
+			}
+		}
+
+		// Log the full response body at debug level; the error carries only
+		// Polar's error name and detail.
+		tflog.Debug(ctx, "supplemental HTTP error response", map[string]interface{}{
+			"status": resp.StatusCode,
+			"body":   string(respBody),
+		})
+		return decodeSupplementalError(resp.StatusCode, respBody)
+	}
+}
+
+// retryBackoff computes the backoff duration for a retry. If the response
+// includes a Retry-After header (seconds), that value is used (capped at
+// policy.MaxInterval). Otherwise, exponential backoff is applied. resp is nil
+// when retrying a connection error.
+func retryBackoff(resp *http.Response, attempt int, policy retryPolicy) time.Duration {
+	if resp != nil {
+		if ra := resp.Header.Get("Retry-After"); ra != "" {
+			if seconds, err := strconv.Atoi(ra); err == nil && seconds > 0 {
+				d := time.Duration(seconds) * time.Second
+				if d > policy.MaxInterval {
+					d = policy.MaxInterval
+				}
+				return d
+			}
+		}
+	}
+	backoff := time.Duration(float64(policy.InitialInterval) * math.Pow(policy.Exponent, float64(attempt)))
+	if backoff > policy.MaxInterval {
+		backoff = policy.MaxInterval
+	}
+	return backoff
+}
+
+// isConnectionError reports whether err is a transient transport failure: a
+// refused or reset connection, or a timeout. Other transport errors, such as
+// TLS certificate failures or a rejected redirect, would fail the same way on
+// every attempt and are not retried. Cancellation of ctx itself is never
+// retried.
+func isConnectionError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,411 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestSupplementalClient_requests(t *testing.T) {
+	type widget struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	var got []*http.Request
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got = append(got, r)
+		bodies = append(bodies, string(body))
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"id": "w_1", "name": "Widget"}`)
+		}
+	}))
+	defer server.Close()
+
+	c := newSupplementalClient(server.URL, "polar_oat_secret", server.Client(), defaultRetryPolicy)
+	ctx := context.Background()
+
+	if w, err := supplementalGet[widget](ctx, c, "/v1/widgets/w_1"); err != nil || w.Name != "Widget" {
+		t.Fatalf("GET = %+v, %v", w, err)
+	}
+	if w, err := supplementalPost[widget](ctx, c, "/v1/widgets/", widget{Name: "Widget"}); err != nil || w.ID != "w_1" {
+		t.Fatalf("POST = %+v, %v", w, err)
+	}
+	if _, err := supplementalPatch[widget](ctx, c, "/v1/widgets/w_1", map[string]any{"name": "Widget"}); err != nil {
+		t.Fatalf("PATCH: %v", err)
+	}
+	if err := c.do(ctx, http.MethodDelete, "/v1/widgets/w_1", nil, nil); err != nil {
+		t.Fatalf("DELETE: %v", err)
+	}
+
+	wantMethods := []string{http.MethodGet, http.MethodPost, http.MethodPatch, http.MethodDelete}
+	if len(got) != len(wantMethods) {
+		t.Fatalf("got %d requests, want %d", len(got), len(wantMethods))
+	}
+	for i, r := range got {
+		if r.Method != wantMethods[i] {
+			t.Errorf("request %d method = %s, want %s", i, r.Method, wantMethods[i])
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer polar_oat_secret" {
+			t.Errorf("request %d Authorization = %q", i, auth)
+		}
+		hasBody := r.Method == http.MethodPost || r.Method == http.MethodPatch
+		if ct := r.Header.Get("Content-Type"); hasBody != (ct == "application/json") {
+			t.Errorf("request %d Content-Type = %q", i, ct)
+		}
+		if hasBody && !json.Valid([]byte(bodies[i])) {
+			t.Errorf("request %d body = %q, want JSON", i, bodies[i])
+		}
+	}
+}
+
+func TestSupplementalClient_errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		body          string
+		wantErr       string
+		wantNotFound  bool
+		wantTransient bool
+	}{
+		{
+			name:         "not found",
+			status:       http.StatusNotFound,
+			body:         `{"error": "ResourceNotFound", "detail": "Not found"}`,
+			wantErr:      "failed with status 404 (ResourceNotFound): Not found",
+			wantNotFound: true,
+		},
+		{
+			// A 404 from a proxy or a wrong base_url isn't Polar saying the
+			// resource is gone.
+			name:    "not found without Polar error body",
+			status:  http.StatusNotFound,
+			body:    `<html>404 Not Found</html>`,
+			wantErr: "failed with status 404",
+		},
+		{
+			name:    "validation error",
+			status:  http.StatusUnprocessableEntity,
+			body:    `{"detail": [{"loc": ["body", "subscription_settings", "proration_behavior"], "msg": "Input should be 'invoice' or 'prorate'", "type": "enum"}, {"loc": ["query"], "msg": "Field required", "type": "missing"}]}`,
+			wantErr: "failed with status 422: subscription_settings.proration_behavior: Input should be 'invoice' or 'prorate'; query: Field required",
+		},
+		{
+			name:    "forbidden",
+			status:  http.StatusForbidden,
+			body:    `{"error": "NotPermitted", "detail": "Not permitted"}`,
+			wantErr: "failed with status 403 (NotPermitted): Not permitted",
+		},
+		{
+			name:          "unavailable without JSON body",
+			status:        http.StatusServiceUnavailable,
+			body:          `<html>Service Unavailable</html>`,
+			wantErr:       "failed with status 503",
+			wantTransient: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer server.Close()
+
+			// MaxElapsed of zero fails on the first 5xx instead of retrying.
+			c := newSupplementalClient(server.URL, "polar_oat_secret", server.Client(), retryPolicy{})
+			err := c.do(context.Background(), http.MethodGet, "/v1/widgets/w_1", nil, nil)
+
+			var apiErr *supplementalAPIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tt.status {
+				t.Fatalf("err = %v, want a *supplementalAPIError with status %d", err, tt.status)
+			}
+			if !strings.HasSuffix(err.Error(), tt.wantErr) {
+				t.Errorf("err = %q, want it to end with %q", err, tt.wantErr)
+			}
+			if got := isNotFound(err); got != tt.wantNotFound {
+				t.Errorf("isNotFound = %v, want %v", got, tt.wantNotFound)
+			}
+			if got := isTransient(err); got != tt.wantTransient {
+				t.Errorf("isTransient = %v, want %v", got, tt.wantTransient)
+			}
+		})
+	}
+}
+
+func TestSupplementalClient_redactsLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error": "Unauthorized", "detail": "Token polar_oat_leaked is invalid"}`)
+	}))
+	defer server.Close()
+
+	var logs strings.Builder
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+	c := newSupplementalClient(server.URL, "polar_oat_leaked", server.Client(), defaultRetryPolicy)
+	if err := c.do(ctx, http.MethodGet, "/v1/widgets/", nil, nil); err == nil {
+		t.Fatal("request succeeded, want 401")
+	}
+
+	if !strings.Contains(logs.String(), "supplemental HTTP error response") {
+		t.Fatalf("error response not logged:\n%s", logs.String())
+	}
+	if strings.Contains(logs.String(), "polar_oat_leaked") {
+		t.Errorf("logs contain the access token:\n%s", logs.String())
+	}
+}
+
+// flakyTransport fails the first request with a connection error and
+// answers every later one with an empty JSON object.
+type flakyTransport struct{ attempts int }
+
+func (f *flakyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.attempts++
+	if f.attempts == 1 {
+		return nil, fmt.Errorf("read tcp: %w", syscall.ECONNRESET)
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(`{}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestSupplementalClient_connectionRetries(t *testing.T) {
+	tests := []struct {
+		method       string
+		wantAttempts int
+	}{
+		{http.MethodGet, 2},
+		{http.MethodPatch, 2},
+		{http.MethodDelete, 2},
+		// Not retried: the failed POST may already have created the object.
+		{http.MethodPost, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			transport := &flakyTransport{}
+			policy := defaultRetryPolicy
+			policy.RetryConnectionErrors = true
+			policy.Clock = &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+			c := newSupplementalClient("https://api.polar.sh", "polar_oat_secret", &http.Client{Transport: transport}, policy)
+
+			err := c.do(context.Background(), tt.method, "/v1/widgets/", map[string]any{}, nil)
+			if tt.wantAttempts > 1 && err != nil {
+				t.Fatalf("err = %v, want success after a retry", err)
+			}
+			if tt.wantAttempts == 1 && (err == nil || !strings.Contains(err.Error(), "connection reset")) {
+				t.Fatalf("err = %v, want the connection error", err)
+			}
+			if transport.attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", transport.attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+// retryResponse is one scripted outcome of a doWithRetry attempt: a
+// response with the given status and Retry-After header, or err.
+type retryResponse struct {
+	status     int
+	retryAfter string
+	err        error
+}
+
+func (r retryResponse) response() (*http.Response, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	resp := &http.Response{
+		StatusCode: r.status,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(`{"detail":"scripted"}`)),
+	}
+	if r.retryAfter != "" {
+		resp.Header.Set("Retry-After", r.retryAfter)
+	}
+	return resp, nil
+}
+
+func TestDoWithRetry_faults(t *testing.T) {
+	policy := retryPolicy{
+		InitialInterval: time.Second,
+		MaxInterval:     10 * time.Second,
+		Exponent:        2,
+		MaxElapsed:      20 * time.Second,
+	}
+	connErr := &url.Error{Op: "Get", URL: "https://api.polar.sh", Err: syscall.ECONNREFUSED}
+	timeoutErr := &url.Error{Op: "Get", URL: "https://api.polar.sh", Err: os.ErrDeadlineExceeded}
+	certErr := &url.Error{Op: "Get", URL: "https://api.polar.sh", Err: &tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}}}
+
+	tests := []struct {
+		name      string
+		responses []retryResponse // the last response repeats
+		// connRetries sets policy.RetryConnectionErrors.
+		connRetries bool
+		cancel      bool // cancel the context during the first wait
+		wantErr     string
+		wantWaits   []time.Duration
+	}{
+		{
+			name:      "success",
+			responses: []retryResponse{{status: 200}},
+		},
+		{
+			name:      "server errors then success",
+			responses: []retryResponse{{status: 502}, {status: 503}, {status: 200}},
+			wantWaits: []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name:      "429 with Retry-After",
+			responses: []retryResponse{{status: 429, retryAfter: "3"}, {status: 200}},
+			wantWaits: []time.Duration{3 * time.Second},
+		},
+		{
+			name:      "Retry-After capped at max interval",
+			responses: []retryResponse{{status: 429, retryAfter: "120"}, {status: 200}},
+			wantWaits: []time.Duration{10 * time.Second},
+		},
+		{
+			name:      "unparseable Retry-After falls back to backoff",
+			responses: []retryResponse{{status: 429, retryAfter: "soon"}, {status: 200}},
+			wantWaits: []time.Duration{time.Second},
+		},
+		{
+			name:      "max elapsed exhausted",
+			responses: []retryResponse{{status: 503}},
+			wantErr:   "failed with status 503",
+			// 1s + 2s + 4s + 8s = 15s; another 10s would pass 20s.
+			wantWaits: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second},
+		},
+		{
+			name:      "client error not retried",
+			responses: []retryResponse{{status: 422}},
+			wantErr:   "failed with status 422",
+		},
+		{
+			name:      "connection error not retried by default",
+			responses: []retryResponse{{err: connErr}, {status: 200}},
+			wantErr:   "connection refused",
+		},
+		{
+			name:        "connection error retried when enabled",
+			responses:   []retryResponse{{err: connErr}, {status: 200}},
+			connRetries: true,
+			wantWaits:   []time.Duration{time.Second},
+		},
+		{
+			name:        "timeout retried when enabled",
+			responses:   []retryResponse{{err: timeoutErr}, {status: 200}},
+			connRetries: true,
+			wantWaits:   []time.Duration{time.Second},
+		},
+		{
+			name:        "certificate error not retried",
+			responses:   []retryResponse{{err: certErr}, {status: 200}},
+			connRetries: true,
+			wantErr:     "certificate signed by unknown authority",
+		},
+		{
+			name:      "context cancelled",
+			responses: []retryResponse{{status: 429, retryAfter: "3"}, {status: 200}},
+			cancel:    true,
+			wantErr:   context.Canceled.Error(),
+			wantWaits: []time.Duration{3 * time.Second},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			clk := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+			if tt.cancel {
+				clk.cancel = cancel
+			}
+			policy := policy
+			policy.RetryConnectionErrors = tt.connRetries
+			policy.Clock = clk
+
+			var attempts int
+			err := doWithRetry(ctx, policy, func() (*http.Response, error) {
+				resp := tt.responses[min(attempts, len(tt.responses)-1)]
+				attempts++
+				return resp.response()
+			})
+
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("err = %v, want success", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("err = %v, want one containing %q", err, tt.wantErr)
+			}
+			if tt.cancel && !errors.Is(err, context.Canceled) {
+				t.Errorf("err = %v, want context.Canceled", err)
+			}
+			if !slices.Equal(clk.waits, tt.wantWaits) {
+				t.Errorf("waits = %v, want %v", clk.waits, tt.wantWaits)
+			}
+			// Every wait but a cancelled one is followed by another attempt.
+			wantAttempts := len(tt.wantWaits) + 1
+			if tt.cancel {
+				wantAttempts--
+			}
+			if attempts != wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := retryPolicy{InitialInterval: 500 * time.Millisecond, MaxInterval: 5 * time.Second, Exponent: 1.5}
+	tests := []struct {
+		name       string
+		attempt    int
+		retryAfter string
+		want       time.Duration
+	}{
+		{name: "first attempt", attempt: 0, want: 500 * time.Millisecond},
+		{name: "grows by exponent", attempt: 2, want: 1125 * time.Millisecond},
+		{name: "capped at max interval", attempt: 10, want: 5 * time.Second},
+		{name: "Retry-After seconds", attempt: 0, retryAfter: "2", want: 2 * time.Second},
+		{name: "Retry-After capped", attempt: 0, retryAfter: "60", want: 5 * time.Second},
+		{name: "zero Retry-After ignored", attempt: 1, retryAfter: "0", want: 750 * time.Millisecond},
+		{name: "HTTP-date Retry-After ignored", attempt: 1, retryAfter: "Wed, 21 Oct 2015 07:28:00 GMT", want: 750 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, _ := retryResponse{status: 429, retryAfter: tt.retryAfter}.response()
+			if got := retryBackoff(resp, tt.attempt, policy); got != tt.want {
+				t.Errorf("retryBackoff(attempt %d, Retry-After %q) = %s, want %s", tt.attempt, tt.retryAfter, got, tt.want)
+			}
+		})
+	}
+
+	// Connection errors have no response and always back off exponentially.
+	if got := retryBackoff(nil, 1, policy); got != 750*time.Millisecond {
+		t.Errorf("retryBackoff(nil, 1) = %s, want 750ms", got)
+	}
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/polarsource/polar-go/models/components"
+)
+
+// webhookEventTypes is the catalogue of webhook events the SDK knows about,
+// in SDK declaration order. The SDK only exposes them as constants, so keep
+// this list in sync when bumping polar-go.
+var webhookEventTypes = []components.WebhookEventType{
+	components.WebhookEventTypeCheckoutCreated,
+	components.WebhookEventTypeCheckoutUpdated,
+	components.WebhookEventTypeCustomerCreated,
+	components.WebhookEventTypeCustomerUpdated,
+	components.WebhookEventTypeCustomerDeleted,
+	components.WebhookEventTypeCustomerStateChanged,
+	components.WebhookEventTypeCustomerSeatAssigned,
+	components.WebhookEventTypeCustomerSeatClaimed,
+	components.WebhookEventTypeCustomerSeatRevoked,
+	components.WebhookEventTypeOrderCreated,
+	components.WebhookEventTypeOrderUpdated,
+	components.WebhookEventTypeOrderPaid,
+	components.WebhookEventTypeOrderRefunded,
+	components.WebhookEventTypeSubscriptionCreated,
+	components.WebhookEventTypeSubscriptionUpdated,
+	components.WebhookEventTypeSubscriptionActive,
+	components.WebhookEventTypeSubscriptionCanceled,
+	components.WebhookEventTypeSubscriptionUncanceled,
+	components.WebhookEventTypeSubscriptionRevoked,
+	components.WebhookEventTypeRefundCreated,
+	components.WebhookEventTypeRefundUpdated,
+	components.WebhookEventTypeProductCreated,
+	components.WebhookEventTypeProductUpdated,
+	components.WebhookEventTypeBenefitCreated,
+	components.WebhookEventTypeBenefitUpdated,
+	components.WebhookEventTypeBenefitGrantCreated,
+	components.WebhookEventTypeBenefitGrantCycled,
+	components.WebhookEventTypeBenefitGrantUpdated,
+	components.WebhookEventTypeBenefitGrantRevoked,
+	components.WebhookEventTypeOrganizationUpdated,
+}
+
+func isWebhookEventType(name string) bool {
+	for _, e := range webhookEventTypes {
+		if string(e) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// matchWebhookEventTypes returns the event types matching any of the glob
+// patterns (path.Match syntax, e.g. "subscription.*"), in catalogue order.
+func matchWebhookEventTypes(patterns []string) ([]string, error) {
+	var result []string
+	for _, e := range webhookEventTypes {
+		for _, p := range patterns {
+			ok, err := path.Match(p, string(e))
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern %q: %w", p, err)
+			}
+			if ok {
+				result = append(result, string(e))
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// nearestWebhookEventType returns the known event type closest to name by
+// edit distance, or "" if nothing is close enough to be a plausible typo.
+func nearestWebhookEventType(name string) string {
+	best, bestDist := "", -1
+	for _, e := range webhookEventTypes {
+		d := levenshtein(name, string(e))
+		if bestDist < 0 || d < bestDist {
+			best, bestDist = string(e), d
+		}
+	}
+	// Allow roughly one edit per four characters; beyond that the suggestion
+	// is more likely to confuse than help.
+	if bestDist > len(name)/4+1 {
+		return ""
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// webhookEventTypeValidator checks a string against the webhook event
+// catalogue and suggests the nearest valid name on a typo.
+type webhookEventTypeValidator struct{}
+
+func (v webhookEventTypeValidator) Description(_ context.Context) string {
+	return "value must be a known Polar webhook event type"
+}
+
+func (v webhookEventTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v webhookEventTypeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	name := req.ConfigValue.ValueString()
+	if isWebhookEventType(name) {
+		return
+	}
+
+	detail := fmt.Sprintf("%q is not a known Polar webhook event type.", name)
+	if suggestion := nearestWebhookEventType(name); suggestion != "" {
+		detail += fmt.Sprintf(" Did you mean %q?", suggestion)
+	}
+	detail += " Use the polar_webhook_event_types data source to list supported events."
+	resp.Diagnostics.AddAttributeError(req.Path, "Unknown webhook event type", detail)
+}
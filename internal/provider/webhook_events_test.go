@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWebhookEventTypeValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       types.String
+		wantErr     bool
+		wantSuggest string
+	}{
+		{name: "known", value: types.StringValue("order.paid")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown value", value: types.StringUnknown()},
+		{name: "british spelling", value: types.StringValue("subscription.cancelled"), wantErr: true, wantSuggest: `Did you mean "subscription.canceled"?`},
+		{name: "missing underscore", value: types.StringValue("benefitgrant.created"), wantErr: true, wantSuggest: `Did you mean "benefit_grant.created"?`},
+		{name: "unrelated", value: types.StringValue("invoice.finalized"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("events"), ConfigValue: tt.value}
+			var resp validator.StringResponse
+			webhookEventTypeValidator{}.ValidateString(context.Background(), req, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Fatalf("HasError() = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+			if !tt.wantErr {
+				return
+			}
+			detail := resp.Diagnostics.Errors()[0].Detail()
+			if tt.wantSuggest != "" && !strings.Contains(detail, tt.wantSuggest) {
+				t.Errorf("detail = %q, want it to contain %q", detail, tt.wantSuggest)
+			}
+			if tt.wantSuggest == "" && strings.Contains(detail, "Did you mean") {
+				t.Errorf("detail = %q, want no suggestion", detail)
+			}
+		})
+	}
+}
+
+func TestMatchWebhookEventTypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:     "subscription family",
+			patterns: []string{"subscription.*"},
+			want: []string{
+				"subscription.created", "subscription.updated", "subscription.active",
+				"subscription.canceled", "subscription.uncanceled", "subscription.revoked",
+			},
+		},
+		{
+			name:     "multiple patterns keep catalogue order",
+			patterns: []string{"refund.*", "order.paid"},
+			want:     []string{"order.paid", "refund.created", "refund.updated"},
+		},
+		{
+			name:     "customer does not match customer_seat",
+			patterns: []string{"customer.*"},
+			want:     []string{"customer.created", "customer.updated", "customer.deleted", "customer.state_changed"},
+		},
+		{
+			name:     "no match",
+			patterns: []string{"invoice.*"},
+		},
+		{
+			name:     "bad pattern",
+			patterns: []string{"order.[paid"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchWebhookEventTypes(tt.patterns)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchWebhookEventTypes_all(t *testing.T) {
+	got, err := matchWebhookEventTypes([]string{"*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(webhookEventTypes) {
+		t.Errorf("got %d event types, want %d", len(got), len(webhookEventTypes))
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"order.paid", "order.paid", 0},
+		{"subscription.cancelled", "subscription.canceled", 1},
+		{"kitten", "sitting", 3},
+	}
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package webhooktest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Delivery is a request received by a Receiver.
+type Delivery struct {
+	ID        string
+	EventType string
+	Header    http.Header
+	Body      []byte
+	// Err is the verification error, or nil if the signature was valid.
+	Err error
+}
+
+// Receiver is an HTTPS test server that verifies deliveries against an
+// endpoint secret, answering 204 for valid signatures and 401 otherwise.
+// Every request is recorded, valid or not.
+type Receiver struct {
+	server *httptest.Server
+	now    func() time.Time
+
+	mu         sync.Mutex
+	secret     string
+	deliveries []Delivery
+}
+
+// NewReceiver starts a Receiver. The secret can be set later with SetSecret,
+// since Polar only generates it once the endpoint exists. Call Close when done.
+func NewReceiver(secret string) *Receiver {
+	r := &Receiver{secret: secret, now: time.Now}
+	r.server = httptest.NewTLSServer(http.HandlerFunc(r.serveHTTP))
+	return r
+}
+
+// URL returns the receiver's HTTPS URL.
+func (r *Receiver) URL() string {
+	return r.server.URL
+}
+
+// Client returns an HTTP client that trusts the receiver's certificate.
+func (r *Receiver) Client() *http.Client {
+	return r.server.Client()
+}
+
+// Close shuts the receiver down.
+func (r *Receiver) Close() {
+	r.server.Close()
+}
+
+// SetSecret changes the secret deliveries are verified against, e.g. after
+// the endpoint secret is rotated.
+func (r *Receiver) SetSecret(secret string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.secret = secret
+}
+
+// Deliveries returns a copy of every delivery received so far.
+func (r *Receiver) Deliveries() []Delivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.deliveries)
+}
+
+func (r *Receiver) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	r.mu.Lock()
+	secret := r.secret
+	r.mu.Unlock()
+
+	delivery := Delivery{
+		ID:     req.Header.Get(HeaderID),
+		Header: req.Header.Clone(),
+		Body:   body,
+		Err:    Verify(secret, req.Header, body, r.now()),
+	}
+	var envelope struct {
+		Type string `json:"type"`
+	}
+	if json.Unmarshal(body, &envelope) == nil {
+		delivery.EventType = envelope.Type
+	}
+
+	r.mu.Lock()
+	r.deliveries = append(r.deliveries, delivery)
+	r.mu.Unlock()
+
+	if delivery.Err != nil {
+		http.Error(w, delivery.Err.Error(), http.StatusUnauthorized)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package webhooktest
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Signer produces signed sample deliveries the way Polar sends them.
+type Signer struct {
+	// Secret is the endpoint secret, e.g. polar_webhook_endpoint.secret.
+	Secret string
+	// Now returns the delivery timestamp. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// samplePayload is the envelope Polar wraps every event in.
+type samplePayload struct {
+	Type      string         `json:"type"`
+	Timestamp time.Time      `json:"timestamp"`
+	Data      map[string]any `json:"data"`
+}
+
+// SamplePayload returns a minimal JSON payload for an event type. The data
+// object only carries an ID and timestamps; it exercises signing, not the
+// shape of each resource.
+func SamplePayload(eventType string, now time.Time) ([]byte, error) {
+	if eventType == "" {
+		return nil, fmt.Errorf("event type is empty")
+	}
+	object, _, _ := strings.Cut(eventType, ".")
+	return json.Marshal(samplePayload{
+		Type:      eventType,
+		Timestamp: now.UTC(),
+		Data: map[string]any{
+			"id":          object + "_webhooktest",
+			"created_at":  now.UTC(),
+			"modified_at": nil,
+		},
+	})
+}
+
+func (s *Signer) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+// Headers returns the Standard Webhooks headers for a delivery of body.
+func (s *Signer) Headers(id string, body []byte) (http.Header, error) {
+	timestamp := s.now()
+	signature, err := Sign(s.Secret, id, timestamp, body)
+	if err != nil {
+		return nil, err
+	}
+	header := http.Header{}
+	header.Set(HeaderID, id)
+	header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	header.Set(HeaderSignature, signature)
+	header.Set("Content-Type", "application/json")
+	return header, nil
+}
+
+// NewRequest builds a signed POST of a sample eventType payload to url.
+func (s *Signer) NewRequest(ctx context.Context, url, eventType string) (*http.Request, error) {
+	body, err := SamplePayload(eventType, s.now())
+	if err != nil {
+		return nil, err
+	}
+	id, err := newMessageID()
+	if err != nil {
+		return nil, err
+	}
+	header, err := s.Headers(id, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header = header
+	return req, nil
+}
+
+// newMessageID returns a random webhook-id in the "msg_..." form.
+func newMessageID() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating message ID: %w", err)
+	}
+	return "msg_" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: MPL-2.0
+
+// Package webhooktest signs and verifies Polar webhook deliveries so tests can
+// check an endpoint's secret end to end without a publicly reachable URL.
+//
+// Polar signs deliveries following the Standard Webhooks specification
+// (https://www.standardwebhooks.com): each request carries webhook-id,
+// webhook-timestamp and webhook-signature headers, and the signature is an
+// HMAC-SHA256 over "<id>.<timestamp>.<body>". Polar uses the raw bytes of the
+// endpoint secret as the HMAC key; secrets in the generic Standard Webhooks
+// form ("whsec_" followed by base64) are decoded first.
+package webhooktest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Standard Webhooks header names.
+const (
+	HeaderID        = "webhook-id"
+	HeaderTimestamp = "webhook-timestamp"
+	HeaderSignature = "webhook-signature"
+)
+
+// DefaultTolerance is how far a delivery's timestamp may be from the
+// verifier's clock before it is rejected as a possible replay.
+const DefaultTolerance = 5 * time.Minute
+
+const (
+	signatureVersion = "v1"
+	secretPrefix     = "whsec_"
+)
+
+var (
+	// ErrMissingHeaders is returned when a delivery lacks any of the
+	// webhook-id, webhook-timestamp or webhook-signature headers.
+	ErrMissingHeaders = errors.New("missing webhook-id, webhook-timestamp or webhook-signature header")
+	// ErrInvalidTimestamp is returned when webhook-timestamp isn't a Unix
+	// timestamp or is outside the tolerance window.
+	ErrInvalidTimestamp = errors.New("invalid or expired webhook-timestamp")
+	// ErrNoMatchingSignature is returned when none of the signatures in
+	// webhook-signature match the payload.
+	ErrNoMatchingSignature = errors.New("no matching signature")
+)
+
+// signingKey returns the HMAC key for an endpoint secret.
+func signingKey(secret string) ([]byte, error) {
+	if secret == "" {
+		return nil, errors.New("webhook secret is empty")
+	}
+	if encoded, ok := strings.CutPrefix(secret, secretPrefix); ok {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("decoding %s secret: %w", secretPrefix, err)
+		}
+		return key, nil
+	}
+	return []byte(secret), nil
+}
+
+// Sign returns the webhook-signature header value for a delivery.
+func Sign(secret, id string, timestamp time.Time, body []byte) (string, error) {
+	key, err := signingKey(secret)
+	if err != nil {
+		return "", err
+	}
+	return signatureVersion + "," + computeSignature(key, id, timestamp.Unix(), body), nil
+}
+
+func computeSignature(key []byte, id string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	fmt.Fprintf(mac, "%s.%d.", id, timestamp)
+	mac.Write(body)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a delivery's headers and body against the endpoint secret,
+// accepting timestamps within DefaultTolerance of now.
+func Verify(secret string, header http.Header, body []byte, now time.Time) error {
+	return VerifyWithTolerance(secret, header, body, now, DefaultTolerance)
+}
+
+// VerifyWithTolerance is Verify with a custom timestamp tolerance.
+func VerifyWithTolerance(secret string, header http.Header, body []byte, now time.Time, tolerance time.Duration) error {
+	id := header.Get(HeaderID)
+	rawTimestamp := header.Get(HeaderTimestamp)
+	signatures := header.Get(HeaderSignature)
+	if id == "" || rawTimestamp == "" || signatures == "" {
+		return ErrMissingHeaders
+	}
+
+	timestamp, err := strconv.ParseInt(rawTimestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+	if skew := now.Sub(time.Unix(timestamp, 0)); skew > tolerance || skew < -tolerance {
+		return ErrInvalidTimestamp
+	}
+
+	key, err := signingKey(secret)
+	if err != nil {
+		return err
+	}
+	expected := []byte(computeSignature(key, id, timestamp, body))
+
+	// The header may hold several space-separated signatures, e.g. while a
+	// secret is being rotated. Any one valid v1 signature is enough.
+	for _, sig := range strings.Fields(signatures) {
+		version, value, ok := strings.Cut(sig, ",")
+		if !ok || version != signatureVersion {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(value), expected) == 1 {
+			return nil
+		}
+	}
+	return ErrNoMatchingSignature
+}
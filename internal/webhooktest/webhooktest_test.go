@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package webhooktest
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// Test vector from the Standard Webhooks reference implementations.
+const (
+	vectorSecret    = "whsec_MfKQ9r8GKYqrTwjUPD8ILPZIo2LaLaSw"
+	vectorID        = "msg_p5jXN8AQM9LWM0D4loKWxJek"
+	vectorTimestamp = 1614265330
+	vectorBody      = `{"test": 2432232314}`
+	vectorSignature = "v1,g0hM9SsE+OTPJTGt/tmIKtSyZlE3uFJELVlNIOLJ1OE="
+)
+
+func TestSign_standardWebhooksVector(t *testing.T) {
+	got, err := Sign(vectorSecret, vectorID, time.Unix(vectorTimestamp, 0), []byte(vectorBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != vectorSignature {
+		t.Errorf("Sign() = %s, want %s", got, vectorSignature)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(vectorTimestamp, 0)
+	headers := func(signature string, timestamp int64) http.Header {
+		h := http.Header{}
+		h.Set(HeaderID, vectorID)
+		h.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+		h.Set(HeaderSignature, signature)
+		return h
+	}
+
+	tests := []struct {
+		name    string
+		secret  string
+		header  http.Header
+		body    string
+		now     time.Time
+		wantErr error
+	}{
+		{name: "valid", secret: vectorSecret, header: headers(vectorSignature, vectorTimestamp), body: vectorBody, now: now},
+		{name: "one of several signatures", secret: vectorSecret, header: headers("v1,bm90LWl0 "+vectorSignature, vectorTimestamp), body: vectorBody, now: now},
+		{name: "tampered body", secret: vectorSecret, header: headers(vectorSignature, vectorTimestamp), body: `{"test": 1}`, now: now, wantErr: ErrNoMatchingSignature},
+		{name: "wrong secret", secret: "polar_whs_other", header: headers(vectorSignature, vectorTimestamp), body: vectorBody, now: now, wantErr: ErrNoMatchingSignature},
+		{name: "unknown version", secret: vectorSecret, header: headers("v2,g0hM9SsE+OTPJTGt/tmIKtSyZlE3uFJELVlNIOLJ1OE=", vectorTimestamp), body: vectorBody, now: now, wantErr: ErrNoMatchingSignature},
+		{name: "stale timestamp", secret: vectorSecret, header: headers(vectorSignature, vectorTimestamp), body: vectorBody, now: now.Add(DefaultTolerance + time.Second), wantErr: ErrInvalidTimestamp},
+		{name: "future timestamp", secret: vectorSecret, header: headers(vectorSignature, vectorTimestamp), body: vectorBody, now: now.Add(-DefaultTolerance - time.Second), wantErr: ErrInvalidTimestamp},
+		{name: "missing headers", secret: vectorSecret, header: http.Header{}, body: vectorBody, now: now, wantErr: ErrMissingHeaders},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.secret, tt.header, []byte(tt.body), tt.now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSigningKey(t *testing.T) {
+	// Polar secrets are used as raw bytes; whsec_ secrets are base64-decoded.
+	key, err := signingKey("polar_whs_abc")
+	if err != nil || string(key) != "polar_whs_abc" {
+		t.Errorf("signingKey(polar_whs_abc) = %q, %v", key, err)
+	}
+	if _, err := signingKey("whsec_!!!"); err == nil {
+		t.Error("expected error for malformed whsec_ secret")
+	}
+	if _, err := signingKey(""); err == nil {
+		t.Error("expected error for empty secret")
+	}
+}
+
+func TestReceiver(t *testing.T) {
+	const secret = "polar_whs_receiver"
+	receiver := NewReceiver(secret)
+	defer receiver.Close()
+
+	send := func(signer *Signer, eventType string) int {
+		t.Helper()
+		req, err := signer.NewRequest(t.Context(), receiver.URL(), eventType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := receiver.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if got := send(&Signer{Secret: secret}, "order.paid"); got != http.StatusNoContent {
+		t.Errorf("valid delivery: status = %d, want %d", got, http.StatusNoContent)
+	}
+	if got := send(&Signer{Secret: "polar_whs_wrong"}, "order.paid"); got != http.StatusUnauthorized {
+		t.Errorf("wrong secret: status = %d, want %d", got, http.StatusUnauthorized)
+	}
+	stale := func() time.Time { return time.Now().Add(-time.Hour) }
+	if got := send(&Signer{Secret: secret, Now: stale}, "order.paid"); got != http.StatusUnauthorized {
+		t.Errorf("stale delivery: status = %d, want %d", got, http.StatusUnauthorized)
+	}
+
+	receiver.SetSecret("polar_whs_rotated")
+	if got := send(&Signer{Secret: "polar_whs_rotated"}, "subscription.canceled"); got != http.StatusNoContent {
+		t.Errorf("after rotation: status = %d, want %d", got, http.StatusNoContent)
+	}
+
+	deliveries := receiver.Deliveries()
+	if len(deliveries) != 4 {
+		t.Fatalf("got %d deliveries, want 4", len(deliveries))
+	}
+	if deliveries[0].Err != nil || deliveries[0].EventType != "order.paid" || deliveries[0].ID == "" {
+		t.Errorf("first delivery = %+v, want a verified order.paid", deliveries[0])
+	}
+	if !errors.Is(deliveries[1].Err, ErrNoMatchingSignature) {
+		t.Errorf("second delivery err = %v, want %v", deliveries[1].Err, ErrNoMatchingSignature)
+	}
+	if !errors.Is(deliveries[2].Err, ErrInvalidTimestamp) {
+		t.Errorf("third delivery err = %v, want %v", deliveries[2].Err, ErrInvalidTimestamp)
+	}
+	if deliveries[3].Err != nil || deliveries[3].EventType != "subscription.canceled" {
+		t.Errorf("fourth delivery = %+v, want a verified subscription.canceled", deliveries[3])
+	}
+}